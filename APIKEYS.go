@@ -1,5 +1,12 @@
 package Binance
 
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
 type APIKEYS struct {
 	KEY    string
 	SECRET string
@@ -13,3 +20,123 @@ func (keys *APIKEYS) Set(KEY string, SECRET string) {
 func (keys *APIKEYS) Get() (KEY string, SECRET string) {
 	return keys.KEY, keys.SECRET
 }
+
+// Masks the secret so that printing a client (or its APIKEYS) never leaks it
+func (keys APIKEYS) String() string {
+	return fmt.Sprintf("{KEY:%s SECRET:%s}", maskCredential(keys.KEY), maskCredential(keys.SECRET))
+}
+
+func (keys APIKEYS) GoString() string {
+	return keys.String()
+}
+
+func maskCredential(credential string) string {
+	if credential == "" {
+		return ""
+	}
+	if len(credential) <= 8 {
+		return "****"
+	}
+	return credential[:4] + "****"
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Credentials provider
+//
+// Consulted every time a request is signed, so that keys can be rotated without rebuilding the client.
+//
+// Set it with 'binance.SetCredentialsProvider(provider)', the keys passed to 'CreateClient' are used otherwise.
+//
+// Returned errors must not contain the credentials themselves.
+type CredentialsProvider interface {
+	Credentials() (KEY string, SECRET string, err error)
+}
+
+// APIKEYS is a static credentials provider
+func (keys *APIKEYS) Credentials() (KEY string, SECRET string, err error) {
+	return keys.KEY, keys.SECRET, nil
+}
+
+func StaticCredentials(KEY string, SECRET string) CredentialsProvider {
+	return &APIKEYS{KEY: KEY, SECRET: SECRET}
+}
+
+// # Credentials callback
+//
+// Lets an external secret manager provide the credentials, it is called every time a request is signed, so any caching is up to the callback.
+type CredentialsFunc func() (KEY string, SECRET string, err error)
+
+func (callback CredentialsFunc) Credentials() (KEY string, SECRET string, err error) {
+	return callback()
+}
+
+// # Environment variables credentials
+//
+// The variables are read every time a request is signed.
+func EnvCredentials(keyVariable string, secretVariable string) CredentialsProvider {
+	return CredentialsFunc(func() (string, string, error) {
+		KEY := os.Getenv(keyVariable)
+		if KEY == "" {
+			return "", "", fmt.Errorf("environment variable '%s' is not set", keyVariable)
+		}
+
+		SECRET := os.Getenv(secretVariable)
+		if SECRET == "" {
+			return "", "", fmt.Errorf("environment variable '%s' is not set", secretVariable)
+		}
+
+		return KEY, SECRET, nil
+	})
+}
+
+// # File credentials
+//
+// Reads the credentials from a JSON file: {"key": "...", "secret": "..."}
+//
+// The file is read again whenever its modification time changes.
+func FileCredentials(path string) CredentialsProvider {
+	return &fileCredentials{path: path}
+}
+
+type fileCredentials struct {
+	mu      sync.Mutex
+	path    string
+	modTime time.Time
+	keys    APIKEYS
+}
+
+func (file *fileCredentials) Credentials() (KEY string, SECRET string, err error) {
+	file.mu.Lock()
+	defer file.mu.Unlock()
+
+	info, err := os.Stat(file.path)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !info.ModTime().Equal(file.modTime) {
+		data, err := os.ReadFile(file.path)
+		if err != nil {
+			return "", "", err
+		}
+
+		var content struct {
+			Key    string `json:"key"`
+			Secret string `json:"secret"`
+		}
+		err = json.Unmarshal(data, &content)
+		if err != nil {
+			// The parsing error could quote the file's content
+			return "", "", fmt.Errorf("credentials file '%s' is not valid JSON", file.path)
+		}
+		if content.Key == "" || content.Secret == "" {
+			return "", "", fmt.Errorf("credentials file '%s' is missing \"key\" or \"secret\"", file.path)
+		}
+
+		file.keys.Set(content.Key, content.Secret)
+		file.modTime = info.ModTime()
+	}
+
+	return file.keys.KEY, file.keys.SECRET, nil
+}
@@ -1,5 +1,7 @@
 package Binance
 
+import "sync"
+
 type Binance struct {
 	configs BinanceConfig
 	Opts    BinanceOptions
@@ -7,8 +9,20 @@ type Binance struct {
 
 	API APIKEYS
 
-	Spot    Spot
-	Futures Futures
+	credentialsMu sync.RWMutex
+	credentials   CredentialsProvider
+
+	// Only set for the clients of a ClientPool
+	pool          *ClientPool
+	accountLimits *usageLimiter
+
+	Spot        Spot
+	Futures     Futures
+	Delivery    Delivery
+	Options     Options
+	Margin      Margin
+	Wallet      Wallet
+	SubAccounts SubAccounts
 }
 
 func CreateReadClient() *Binance {
@@ -18,8 +32,7 @@ func CreateReadClient() *Binance {
 	binance.Opts.init()
 	binance.Logger.init()
 
-	binance.Spot.init(&binance)
-	binance.Futures.init(&binance)
+	binance.initClients()
 
 	return &binance
 }
@@ -28,12 +41,21 @@ func CreateClient(APIKEY string, APISECRET string) *Binance {
 	binance := CreateReadClient()
 	binance.API.Set(APIKEY, APISECRET)
 
-	binance.Spot.init(binance)
-	binance.Futures.init(binance)
+	binance.initClients()
 
 	return binance
 }
 
+func (binance *Binance) initClients() {
+	binance.Spot.init(binance)
+	binance.Futures.init(binance)
+	binance.Delivery.init(binance)
+	binance.Options.init(binance)
+	binance.Margin.init(binance)
+	binance.Wallet.init(binance)
+	binance.SubAccounts.init(binance)
+}
+
 func CreateClientWithOptions(APIKEY string, APISECRET string, recvWindow int64) *Binance {
 	binance := CreateClient(APIKEY, APISECRET)
 
@@ -41,3 +63,51 @@ func CreateClientWithOptions(APIKEY string, APISECRET string, recvWindow int64)
 
 	return binance
 }
+
+// # Sets the credentials provider consulted every time a request is signed
+//
+// Can be called at any time to rotate the keys, pass nil to go back to the keys the client was created with.
+func (binance *Binance) SetCredentialsProvider(provider CredentialsProvider) {
+	binance.credentialsMu.Lock()
+	defer binance.credentialsMu.Unlock()
+
+	binance.credentials = provider
+}
+
+func (binance *Binance) credentialsProvider() CredentialsProvider {
+	binance.credentialsMu.RLock()
+	defer binance.credentialsMu.RUnlock()
+
+	return binance.credentials
+}
+
+// # Derives a client sending its request parameters as given by "placement"
+//
+// "placement" is one of Constants.ParamsPlacements, for per-call use:
+//
+//	client.WithParamsPlacement(Binance.Constants.ParamsPlacements.QUERY).Spot.NewOrder(...)
+//
+// Only the placement is overridden, the requests go through this client (its HTTP connections, options, credentials provider and pool limits),
+// only the market clients (Spot, Futures...) of the derived client are meant to be used.
+func (binance *Binance) WithParamsPlacement(placement string) *Binance {
+	derived := &Binance{
+		Spot:        binance.Spot,
+		Futures:     binance.Futures,
+		Delivery:    binance.Delivery,
+		Options:     binance.Options,
+		Margin:      binance.Margin,
+		Wallet:      binance.Wallet,
+		SubAccounts: binance.SubAccounts,
+	}
+	derived.Futures.Custom.init(&derived.Futures)
+
+	derived.Spot.requestClient.paramsPlacement = placement
+	derived.Futures.requestClient.paramsPlacement = placement
+	derived.Delivery.requestClient.paramsPlacement = placement
+	derived.Options.requestClient.paramsPlacement = placement
+	derived.Margin.requestClient.paramsPlacement = placement
+	derived.Wallet.requestClient.paramsPlacement = placement
+	derived.SubAccounts.requestClient.paramsPlacement = placement
+
+	return derived
+}
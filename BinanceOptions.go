@@ -4,11 +4,13 @@ type BinanceOptions struct {
 	// TODO: need to implement this ASAP!
 	updateTimestampOffset bool
 	recvWindow            int64
+	paramsPlacement       string
 }
 
 func (options *BinanceOptions) init() {
 	options.updateTimestampOffset = false
 	options.recvWindow = 5000
+	options.paramsPlacement = Constants.ParamsPlacements.BODY
 }
 
 func (options *BinanceOptions) Set_UpdateTimestampOffset(value bool) {
@@ -18,3 +20,12 @@ func (options *BinanceOptions) Set_UpdateTimestampOffset(value bool) {
 func (options *BinanceOptions) Set_recvWindow(recvWindow int64) {
 	options.recvWindow = recvWindow
 }
+
+// # Sets where the parameters of signed and API-key requests are sent
+//
+// "placement" is one of Constants.ParamsPlacements, GET requests always use the query string.
+//
+// Use 'binance.WithParamsPlacement(placement)' for a per-call choice.
+func (options *BinanceOptions) Set_ParamsPlacement(placement string) {
+	options.paramsPlacement = placement
+}
@@ -0,0 +1,443 @@
+package Binance
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// # Client pool
+//
+// Manages many API key pairs behind a single IP:
+//
+// - Every account shares the same HTTP transport (and its connections)
+//
+// - IP limits (request weight) are enforced across all the accounts of the pool
+//
+// - Account limits (order count, SAPI UID weight) are enforced separately for each account
+//
+// Limits are read from the response headers, once a limit is reached, the next requests wait for the interval to reset before being sent.
+//
+// Requests waiting for their response count against the limits too (as 1 weight or order each), so that a burst (i.e: FanOut()) can't exceed them before any response reports the usage.
+type ClientPool struct {
+	mu       sync.RWMutex
+	accounts []*ClientPool_Account
+
+	httpClient    *http.Client
+	ipLimits      *usageLimiter
+	accountLimits map[string]int64
+}
+
+type ClientPool_Account struct {
+	Name   string
+	Client *Binance
+}
+
+// Local IP limits, keyed by the response header they are read from
+//
+// Used for the base URLs missing from ClientPool_DefaultIPLimitsByBaseURL
+var ClientPool_DefaultIPLimits = map[string]int64{
+	"X-Mbx-Used-Weight-1m":     2350,
+	"X-Sapi-Used-Ip-Weight-1m": 11800,
+}
+
+// Local IP limits of each market's base URLs, keyed by the response header they are read from
+var ClientPool_DefaultIPLimitsByBaseURL = defaultIPLimitsByBaseURL()
+
+func defaultIPLimitsByBaseURL() map[string]map[string]int64 {
+	limitsByBaseURL := make(map[string]map[string]int64)
+
+	// Spot (6000 weight per minute), shared with the margin, wallet and sub-account endpoints (12000 SAPI weight per minute)
+	for _, baseURL := range SPOT_Constants.URLs {
+		limitsByBaseURL[baseURL] = map[string]int64{
+			"X-Mbx-Used-Weight-1m":     5900,
+			"X-Sapi-Used-Ip-Weight-1m": 11800,
+		}
+	}
+
+	// USDⓈ-M and COIN-M futures (2400 weight per minute)
+	for _, baseURL := range FUTURES_Constants.URLs {
+		limitsByBaseURL[baseURL] = map[string]int64{"X-Mbx-Used-Weight-1m": 2350}
+	}
+	for _, baseURL := range DELIVERY_Constants.URLs {
+		limitsByBaseURL[baseURL] = map[string]int64{"X-Mbx-Used-Weight-1m": 2350}
+	}
+
+	return limitsByBaseURL
+}
+
+// Local account limits, keyed by the response header they are read from
+//
+// Order counts only hold back the requests placing or modifying orders, see isOrderEndpoint()
+var ClientPool_DefaultAccountLimits = map[string]int64{
+	"X-Mbx-Order-Count-10s":     95,
+	"X-Mbx-Order-Count-1m":      1150,
+	"X-Mbx-Order-Count-1d":      199000,
+	"X-Sapi-Used-Uid-Weight-1m": 178000,
+}
+
+type ClientPool_Params struct {
+	// Shared by every account of the pool
+	//
+	// Defaults to an http.Client using a clone of http.DefaultTransport
+	HTTPClient *http.Client
+
+	// Shared by every account of the pool, tracked separately for each base URL
+	//
+	// Used for the base URLs missing from IPLimitsByBaseURL, defaults to ClientPool_DefaultIPLimits
+	IPLimits map[string]int64
+
+	// IP limits of specific base URLs, replacing IPLimits for them
+	//
+	// Defaults to ClientPool_DefaultIPLimitsByBaseURL
+	IPLimitsByBaseURL map[string]map[string]int64
+
+	// Tracked separately for each account of the pool and each base URL
+	//
+	// Defaults to ClientPool_DefaultAccountLimits
+	AccountLimits map[string]int64
+}
+
+func CreateClientPool(opt_params ...ClientPool_Params) *ClientPool {
+	pool := &ClientPool{
+		httpClient:    &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()},
+		accountLimits: ClientPool_DefaultAccountLimits,
+	}
+
+	ipLimits := ClientPool_DefaultIPLimits
+	ipLimitsByBaseURL := ClientPool_DefaultIPLimitsByBaseURL
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if params.HTTPClient != nil {
+			pool.httpClient = params.HTTPClient
+		}
+		if params.IPLimits != nil {
+			ipLimits = params.IPLimits
+		}
+		if params.IPLimitsByBaseURL != nil {
+			ipLimitsByBaseURL = params.IPLimitsByBaseURL
+		}
+		if params.AccountLimits != nil {
+			pool.accountLimits = params.AccountLimits
+		}
+	}
+
+	pool.ipLimits = newUsageLimiter(ipLimits)
+	for baseURL, limits := range ipLimitsByBaseURL {
+		pool.ipLimits.setBaseURLLimits(baseURL, limits)
+	}
+
+	return pool
+}
+
+// # Adds an account to the pool
+//
+// If an account with the same name already exists, it is replaced
+func (pool *ClientPool) AddAccount(name string, APIKEY string, APISECRET string) *ClientPool_Account {
+	return pool.addAccount(name, CreateClient(APIKEY, APISECRET))
+}
+
+// # Adds an account whose keys are fetched from "provider" every time a request is signed
+//
+// If an account with the same name already exists, it is replaced
+func (pool *ClientPool) AddAccountWithCredentials(name string, provider CredentialsProvider) *ClientPool_Account {
+	client := CreateClient("", "")
+	client.SetCredentialsProvider(provider)
+
+	return pool.addAccount(name, client)
+}
+
+func (pool *ClientPool) addAccount(name string, client *Binance) *ClientPool_Account {
+	client.pool = pool
+	client.accountLimits = newUsageLimiter(pool.accountLimits)
+
+	account := &ClientPool_Account{
+		Name:   name,
+		Client: client,
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	for i, existing := range pool.accounts {
+		if existing.Name == name {
+			pool.accounts[i] = account
+			return account
+		}
+	}
+	pool.accounts = append(pool.accounts, account)
+
+	return account
+}
+
+// Returns false if no account with that name exists
+func (pool *ClientPool) RemoveAccount(name string) bool {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	for i, account := range pool.accounts {
+		if account.Name == name {
+			pool.accounts = append(pool.accounts[:i], pool.accounts[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns nil if no account with that name exists
+func (pool *ClientPool) Account(name string) *ClientPool_Account {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	for _, account := range pool.accounts {
+		if account.Name == name {
+			return account
+		}
+	}
+
+	return nil
+}
+
+// Returns the accounts in the order they were added
+func (pool *ClientPool) Accounts() []*ClientPool_Account {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	accounts := make([]*ClientPool_Account, len(pool.accounts))
+	copy(accounts, pool.accounts)
+
+	return accounts
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type ClientPool_Result[T any] struct {
+	Account *ClientPool_Account
+
+	Value    T
+	Response *Response
+	Error    *Error
+}
+
+// # Calls "call" for every account of the pool concurrently
+//
+// Results are returned in the same order as pool.Accounts(), once every call has returned
+//
+//	results := Binance.FanOut(pool, func(account *Binance.ClientPool_Account) (*Binance.Spot_AccountInfo, *Binance.Response, *Binance.Error) {
+//		return account.Client.Spot.AccountInfo()
+//	})
+func FanOut[T any](pool *ClientPool, call func(account *ClientPool_Account) (T, *Response, *Error)) []*ClientPool_Result[T] {
+	accounts := pool.Accounts()
+	results := make([]*ClientPool_Result[T], len(accounts))
+
+	var wg sync.WaitGroup
+	for i, account := range accounts {
+		wg.Add(1)
+		go func(i int, account *ClientPool_Account) {
+			defer wg.Done()
+
+			value, resp, err := call(account)
+			results[i] = &ClientPool_Result[T]{
+				Account:  account,
+				Value:    value,
+				Response: resp,
+				Error:    err,
+			}
+		}(i, account)
+	}
+	wg.Wait()
+
+	return results
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// Tracks the usage headers of the responses, for each base URL, against local limits
+type usageLimiter struct {
+	mu sync.Mutex
+
+	limits map[string]int64
+	// Replace "limits" for their base URL
+	limitsByBaseURL map[string]map[string]int64
+	windows         map[string]*usageWindow
+	blockedUntil    map[string]time.Time
+
+	// Requests sent for each base URL whose response hasn't reported the usage yet
+	inFlight       map[string]int64
+	inFlightOrders map[string]int64
+	// Closed and replaced every time a request is released
+	released chan struct{}
+}
+
+type usageWindow struct {
+	value int64
+	end   time.Time
+}
+
+func newUsageLimiter(limits map[string]int64) *usageLimiter {
+	return &usageLimiter{
+		limits:          canonicalLimits(limits),
+		limitsByBaseURL: make(map[string]map[string]int64),
+		windows:         make(map[string]*usageWindow),
+		blockedUntil:    make(map[string]time.Time),
+
+		inFlight:       make(map[string]int64),
+		inFlightOrders: make(map[string]int64),
+		released:       make(chan struct{}),
+	}
+}
+
+func canonicalLimits(limits map[string]int64) map[string]int64 {
+	canonicalLimits := make(map[string]int64, len(limits))
+	for header, limit := range limits {
+		canonicalLimits[http.CanonicalHeaderKey(header)] = limit
+	}
+
+	return canonicalLimits
+}
+
+func (limiter *usageLimiter) setBaseURLLimits(baseURL string, limits map[string]int64) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.limitsByBaseURL[baseURL] = canonicalLimits(limits)
+}
+
+// Must be called with "mu" held
+func (limiter *usageLimiter) limitsOf(baseURL string) map[string]int64 {
+	limits, exists := limiter.limitsByBaseURL[baseURL]
+	if exists {
+		return limits
+	}
+	return limiter.limits
+}
+
+// # Blocks until a request to "baseURL" fits in the limits, and counts it in flight until release() is called
+//
+// The usage reported by the last responses and the requests still in flight are both counted,
+// order count limits are only taken into account if "isOrder" is true
+func (limiter *usageLimiter) reserve(baseURL string, isOrder bool) {
+	for {
+		limiter.mu.Lock()
+		now := time.Now()
+		deadline := limiter.blockedUntil[baseURL]
+		isFull := false
+		for header, limit := range limiter.limitsOf(baseURL) {
+			isOrderCount := strings.Contains(header, "-Order-Count-")
+			if isOrderCount && !isOrder {
+				continue
+			}
+
+			inFlight := limiter.inFlight[baseURL]
+			if isOrderCount {
+				inFlight = limiter.inFlightOrders[baseURL]
+			}
+
+			var used int64
+			window, exists := limiter.windows[baseURL+" "+header]
+			if exists && window.end.After(now) {
+				used = window.value
+			}
+
+			switch {
+			case used >= limit:
+				if window.end.After(deadline) {
+					deadline = window.end
+				}
+			case used+inFlight >= limit:
+				// Room is made once a request in flight is released
+				isFull = true
+			}
+		}
+
+		if !deadline.After(now) && !isFull {
+			limiter.inFlight[baseURL]++
+			if isOrder {
+				limiter.inFlightOrders[baseURL]++
+			}
+			limiter.mu.Unlock()
+			return
+		}
+		released := limiter.released
+		limiter.mu.Unlock()
+
+		if !deadline.After(now) {
+			<-released
+			continue
+		}
+
+		timer := time.NewTimer(deadline.Sub(now))
+		select {
+		case <-timer.C:
+		case <-released:
+			timer.Stop()
+		}
+	}
+}
+
+// Stops counting a request reserved with reserve(), once its response's usage is updated (or it failed)
+func (limiter *usageLimiter) release(baseURL string, isOrder bool) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.inFlight[baseURL]--
+	if isOrder {
+		limiter.inFlightOrders[baseURL]--
+	}
+
+	close(limiter.released)
+	limiter.released = make(chan struct{})
+}
+
+func (limiter *usageLimiter) update(baseURL string, resp *Response) {
+	now := time.Now()
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	// Binance is telling us to back off
+	if resp.StatusCode == 418 || resp.StatusCode == 429 {
+		retryAfter, err := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
+		if err == nil {
+			blockedUntil := now.Add(time.Duration(retryAfter) * time.Second)
+			if blockedUntil.After(limiter.blockedUntil[baseURL]) {
+				limiter.blockedUntil[baseURL] = blockedUntil
+			}
+		}
+	}
+
+	for header := range limiter.limitsOf(baseURL) {
+		strValue := resp.Header.Get(header)
+		if strValue == "" {
+			continue
+		}
+
+		value, err := strconv.ParseInt(strValue, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		interval, exists, intervalErr := GetIntervalFromString(header[strings.LastIndex(header, "-")+1:])
+		if intervalErr != nil || !exists {
+			continue
+		}
+		duration := time.Duration(interval.Value) * time.Millisecond
+		end := now.Truncate(duration).Add(duration)
+
+		key := baseURL + " " + header
+		window, exists := limiter.windows[key]
+		if !exists || !window.end.Equal(end) {
+			limiter.windows[key] = &usageWindow{value: value, end: end}
+			continue
+		}
+
+		// Responses of concurrent requests can arrive out of order
+		if value > window.value {
+			window.value = value
+		}
+	}
+}
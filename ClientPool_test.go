@@ -0,0 +1,148 @@
+package Binance
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Reserves a request in a goroutine, the returned channel is closed once it is reserved
+func reserveAsync(limiter *usageLimiter, baseURL string, isOrder bool) chan struct{} {
+	reserved := make(chan struct{})
+	go func() {
+		limiter.reserve(baseURL, isOrder)
+		close(reserved)
+	}()
+
+	return reserved
+}
+
+func expectBlocked(t *testing.T, reserved chan struct{}, duration time.Duration) {
+	t.Helper()
+
+	select {
+	case <-reserved:
+		t.Fatal("the request was reserved while the limit was reached")
+	case <-time.After(duration):
+	}
+}
+
+func expectReserved(t *testing.T, reserved chan struct{}, timeout time.Duration) {
+	t.Helper()
+
+	select {
+	case <-reserved:
+	case <-time.After(timeout):
+		t.Fatal("the request was not reserved")
+	}
+}
+
+func TestUsageLimiterBlocksOnRequestsInFlight(t *testing.T) {
+	const baseURL = "https://api.binance.com"
+	limiter := newUsageLimiter(map[string]int64{"X-Mbx-Used-Weight-1m": 2})
+
+	limiter.reserve(baseURL, false)
+	limiter.reserve(baseURL, false)
+
+	reserved := reserveAsync(limiter, baseURL, false)
+	expectBlocked(t, reserved, 50*time.Millisecond)
+
+	// Other base URLs are tracked separately
+	expectReserved(t, reserveAsync(limiter, "https://fapi.binance.com", false), time.Second)
+
+	limiter.release(baseURL, false)
+	expectReserved(t, reserved, time.Second)
+}
+
+func TestUsageLimiterOrderCountsOnlyHoldBackOrders(t *testing.T) {
+	const baseURL = "https://api.binance.com"
+	limiter := newUsageLimiter(map[string]int64{"X-Mbx-Order-Count-10s": 1, "X-Mbx-Used-Weight-1m": 100})
+
+	limiter.reserve(baseURL, true)
+
+	expectReserved(t, reserveAsync(limiter, baseURL, false), time.Second)
+
+	order := reserveAsync(limiter, baseURL, true)
+	expectBlocked(t, order, 50*time.Millisecond)
+
+	limiter.release(baseURL, true)
+	expectReserved(t, order, time.Second)
+}
+
+func TestUsageLimiterRetryAfter(t *testing.T) {
+	const baseURL = "https://api.binance.com"
+	limiter := newUsageLimiter(map[string]int64{"X-Mbx-Used-Weight-1m": 100})
+
+	limiter.update(baseURL, &Response{StatusCode: 429, Header: http.Header{"Retry-After": []string{"1"}}})
+
+	start := time.Now()
+	reserved := reserveAsync(limiter, baseURL, false)
+	expectBlocked(t, reserved, 500*time.Millisecond)
+	expectReserved(t, reserved, 2*time.Second)
+
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("the request was reserved after %s instead of the 1s Retry-After", elapsed)
+	}
+}
+
+func TestUsageLimiterUpdateKeepsTheHighestUsage(t *testing.T) {
+	const baseURL = "https://api.binance.com"
+	limiter := newUsageLimiter(map[string]int64{"X-Mbx-Used-Weight-1m": 10})
+
+	// The response of the latest request arrives first
+	limiter.update(baseURL, &Response{StatusCode: 200, Header: http.Header{"X-Mbx-Used-Weight-1m": []string{"10"}}})
+	limiter.update(baseURL, &Response{StatusCode: 200, Header: http.Header{"X-Mbx-Used-Weight-1m": []string{"4"}}})
+
+	window := limiter.windows[baseURL+" X-Mbx-Used-Weight-1m"]
+	if window == nil || window.value != 10 {
+		t.Fatalf("expected the usage to stay at 10, got %+v", window)
+	}
+
+	// Blocked until the window ends, not released by requests in flight
+	expectBlocked(t, reserveAsync(limiter, baseURL, false), 50*time.Millisecond)
+}
+
+func TestUsageLimiterBaseURLLimits(t *testing.T) {
+	limiter := newUsageLimiter(ClientPool_DefaultIPLimits)
+	for baseURL, limits := range ClientPool_DefaultIPLimitsByBaseURL {
+		limiter.setBaseURLLimits(baseURL, limits)
+	}
+
+	cases := map[string]int64{
+		SPOT_Constants.URLs[0]:     5900,
+		SPOT_Constants.URLs[3]:     5900,
+		FUTURES_Constants.URLs[0]:  2350,
+		DELIVERY_Constants.URLs[0]: 2350,
+		"https://unknown.binance":  2350,
+	}
+	for baseURL, expected := range cases {
+		if limit := limiter.limitsOf(baseURL)["X-Mbx-Used-Weight-1m"]; limit != expected {
+			t.Errorf("%s: expected a weight limit of %d, got %d", baseURL, expected, limit)
+		}
+	}
+}
+
+func TestIsOrderEndpoint(t *testing.T) {
+	cases := []struct {
+		method  string
+		URL     string
+		isOrder bool
+	}{
+		{"POST", "/api/v3/order", true},
+		{"POST", "/api/v3/orderList/oco", true},
+		{"PUT", "/fapi/v1/order", true},
+		{"POST", "/fapi/v1/batchOrders", true},
+		{"POST", "/sapi/v1/margin/order", true},
+		{"GET", "/api/v3/order", false},
+		{"DELETE", "/api/v3/order", false},
+		{"POST", "/api/v3/userDataStream", false},
+		{"PUT", "/fapi/v1/listenKey", false},
+		{"POST", "/sapi/v1/asset/transfer", false},
+		{"POST", "/sapi/v1/sub-account/universalTransfer", false},
+	}
+	for _, c := range cases {
+		if isOrder := isOrderEndpoint(c.method, c.URL); isOrder != c.isOrder {
+			t.Errorf("%s %s: expected %t, got %t", c.method, c.URL, c.isOrder, isOrder)
+		}
+	}
+}
@@ -28,8 +28,10 @@ var COMPLEX_INTERVALS = struct {
 }
 
 var Constants = struct {
-	Methods   Methods
-	Websocket WebsocketConstants
+	Methods          Methods
+	ParamsPlacements ParamsPlacements
+	Websocket        WebsocketConstants
+	WebsocketStates  WebsocketStates
 }{
 	Methods: Methods{
 		GET:    "GET",
@@ -38,6 +40,10 @@ var Constants = struct {
 		PATCH:  "PATCH",
 		DELETE: "DELETE",
 	},
+	ParamsPlacements: ParamsPlacements{
+		QUERY: "QUERY",
+		BODY:  "BODY",
+	},
 	Websocket: WebsocketConstants{
 		MAX_STREAMS_PER_SOCKET:              1024,
 		MAX_OUTGOING_MESSAGES_PER_SECOND:    5,
@@ -46,6 +52,22 @@ var Constants = struct {
 		HEARTBEAT_CHECK_INTERVAL_SEC:        5,
 		HEARTBEAT_CLOSE_ON_NO_HEARTBEAT_SEC: 20,
 		EXPECTED_DISCONNECTION_TIME_SEC:     (DAY - 5*MINUTE) / 1000,
+		WRITE_TIMEOUT_SEC:                   10,
+		WRITE_QUEUE_SIZE:                    64,
+		MAX_URL_LENGTH:                      8000,
+		ROTATION_OVERLAP_SEC:                5,
+		ROTATION_MAX_OVERLAPS:               4,
+		RECONNECT_MIN_DELAY_MS:              500,
+		RECONNECT_MAX_DELAY_MS:              30000,
+		RECONNECT_MAX_ATTEMPTS:              0,
+		BACKFILL_MAX_REQUESTS:               20,
+		RECONCILE_INTERVAL_SEC:              60,
+	},
+	WebsocketStates: WebsocketStates{
+		CONNECTING:   "CONNECTING",
+		OPEN:         "OPEN",
+		RECONNECTING: "RECONNECTING",
+		CLOSED:       "CLOSED",
 	},
 }
 
@@ -57,6 +79,13 @@ type Methods struct {
 	DELETE string
 }
 
+// Where the parameters of signed and API-key requests are sent, GET requests always use the query string
+type ParamsPlacements struct {
+	QUERY string
+	// application/x-www-form-urlencoded body, the default
+	BODY string
+}
+
 type WebsocketConstants struct {
 	MAX_STREAMS_PER_SOCKET              uint64
 	MAX_OUTGOING_MESSAGES_PER_SECOND    uint64
@@ -65,4 +94,34 @@ type WebsocketConstants struct {
 	HEARTBEAT_CHECK_INTERVAL_SEC        int64
 	HEARTBEAT_CLOSE_ON_NO_HEARTBEAT_SEC int64
 	EXPECTED_DISCONNECTION_TIME_SEC     int64
+	// A write taking longer fails and drops the connection
+	WRITE_TIMEOUT_SEC int64
+	// Messages waiting for the socket's writer
+	WRITE_QUEUE_SIZE int64
+	// Longest connection URL (streams included) the stream manager dials
+	MAX_URL_LENGTH uint64
+	// How long a connection and its replacement both deliver messages when rotating before EXPECTED_DISCONNECTION_TIME_SEC
+	ROTATION_OVERLAP_SEC int64
+	// Overlaps waited at most for the old connection to catch up with the replacement before closing it
+	ROTATION_MAX_OVERLAPS int
+	// Delay before the second reconnection attempt, doubled after every failed attempt
+	RECONNECT_MIN_DELAY_MS int64
+	// Upper bound of the reconnection delay
+	RECONNECT_MAX_DELAY_MS int64
+	// Failed reconnection attempts in a row before the socket is closed, 0 retries forever
+	RECONNECT_MAX_ATTEMPTS int
+	// REST requests per stream when backfilling what was missed during a reconnection
+	BACKFILL_MAX_REQUESTS int
+	// Default interval between two subscription reconciliations, see Websocket.StartReconciliation()
+	RECONCILE_INTERVAL_SEC int64
+}
+
+type WebsocketStates struct {
+	// Dialing the first connection
+	CONNECTING string
+	OPEN       string
+	// The connection was lost (or replaced), dialing a new one
+	RECONNECTING string
+	// Closed with 'Close()', never reconnects
+	CLOSED string
 }
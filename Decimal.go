@@ -0,0 +1,545 @@
+package Binance
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// # Exact decimal number
+//
+// Fixed-point value stored as an integer coefficient and a decimal scale (value = coefficient * 10^-scale).
+//
+// Coefficients fitting in an int64 (up to 18 significant digits) are handled without allocating, larger ones fall back to math/big transparently.
+//
+// The zero value is 0, values are immutable and safe to share between goroutines.
+//
+// Marshals to and from Binance's string numbers ("0.00100000"), and implements fmt.Stringer so it can be passed as a request parameter.
+//
+// Scope: the REST types' price, quantity, volume and filter fields, the candlesticks, the orderbooks and the managed orderbook/candlesticks are Decimal,
+// as are the filter checks (ValidateOrder(), PRICE_FILTER(), LOT_SIZE()...) and the tick/step rounding helpers.
+// The websocket event types keep Binance's strings as they are, parse a field with ParseDecimal() when it needs exact arithmetic, i.e: ParseDecimal(trade.Price).
+type Decimal struct {
+	coef  int64
+	big   *big.Int
+	scale int32
+}
+
+type RoundingMode int
+
+const (
+	// Towards negative infinity
+	ROUND_FLOOR RoundingMode = iota
+	// Towards positive infinity
+	ROUND_CEIL
+	// Towards zero
+	ROUND_DOWN
+	// To the nearest, ties away from zero
+	ROUND_HALF_UP
+)
+
+var pow10_int64 = [19]int64{
+	1, 10, 100, 1_000, 10_000, 100_000, 1_000_000, 10_000_000, 100_000_000, 1_000_000_000,
+	10_000_000_000, 100_000_000_000, 1_000_000_000_000, 10_000_000_000_000, 100_000_000_000_000,
+	1_000_000_000_000_000, 10_000_000_000_000_000, 100_000_000_000_000_000, 1_000_000_000_000_000_000,
+}
+
+func NewDecimal(coefficient int64, scale int32) Decimal {
+	return Decimal{coef: coefficient, scale: scale}.normalizeScale()
+}
+
+// # Parses a plain decimal string, i.e: "123.45", "-0.001", "1000"
+func ParseDecimal(str string) (Decimal, error) {
+	original := str
+	if str == "" {
+		return Decimal{}, fmt.Errorf("cannot parse an empty string as a decimal")
+	}
+
+	negative := false
+	if str[0] == '-' || str[0] == '+' {
+		negative = str[0] == '-'
+		str = str[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(str, ".")
+	digits := intPart + fracPart
+	if digits == "" {
+		return Decimal{}, fmt.Errorf("'%s' is not a valid decimal", original)
+	}
+	for _, char := range digits {
+		if char < '0' || char > '9' {
+			return Decimal{}, fmt.Errorf("'%s' is not a valid decimal", original)
+		}
+	}
+
+	scale := int32(len(fracPart))
+
+	digits = strings.TrimLeft(digits, "0")
+	if len(digits) <= 18 {
+		var coef int64
+		for _, char := range digits {
+			coef = coef*10 + int64(char-'0')
+		}
+		if negative {
+			coef = -coef
+		}
+		return Decimal{coef: coef, scale: scale}, nil
+	}
+
+	coef, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("'%s' is not a valid decimal", original)
+	}
+	if negative {
+		coef.Neg(coef)
+	}
+	return newDecimalFromBig(coef, scale), nil
+}
+
+// Panics if "str" is not a valid decimal, meant for constants
+func MustParseDecimal(str string) Decimal {
+	decimal, err := ParseDecimal(str)
+	if err != nil {
+		panic(err)
+	}
+	return decimal
+}
+
+// # Converts a float64 using its shortest exact representation
+//
+// i.e: 0.1 becomes exactly "0.1", not 0.1000000000000000055511151231257827
+func NewDecimalFromFloat(value float64) (Decimal, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Decimal{}, fmt.Errorf("%v cannot be converted to a decimal", value)
+	}
+	return ParseDecimal(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+func newDecimalFromBig(coef *big.Int, scale int32) Decimal {
+	if coef.IsInt64() {
+		return Decimal{coef: coef.Int64(), scale: scale}
+	}
+	return Decimal{big: coef, scale: scale}
+}
+
+// Negative scales are folded into the coefficient
+func (d Decimal) normalizeScale() Decimal {
+	if d.scale >= 0 {
+		return d
+	}
+	return d.rescale(0)
+}
+
+func (d Decimal) bigCoef() *big.Int {
+	if d.big != nil {
+		return d.big
+	}
+	return big.NewInt(d.coef)
+}
+
+func bigPow10(exponent int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+func mul64(a int64, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	if c/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return c, true
+}
+
+func add64(a int64, b int64) (int64, bool) {
+	c := a + b
+	if (c > a) != (b > 0) {
+		return 0, false
+	}
+	return c, true
+}
+
+// Returns the same value with a higher scale, "scale" must not be lower than d.scale unless d.scale is negative
+func (d Decimal) rescale(scale int32) Decimal {
+	diff := scale - d.scale
+	if diff == 0 {
+		return d
+	}
+
+	if d.big == nil && diff < int32(len(pow10_int64)) {
+		if coef, ok := mul64(d.coef, pow10_int64[diff]); ok {
+			return Decimal{coef: coef, scale: scale}
+		}
+	}
+
+	coef := new(big.Int).Mul(d.bigCoef(), bigPow10(diff))
+	return newDecimalFromBig(coef, scale)
+}
+
+// Returns both values at the same scale
+func align(a Decimal, b Decimal) (Decimal, Decimal) {
+	if a.scale < b.scale {
+		return a.rescale(b.scale), b
+	}
+	return a, b.rescale(a.scale)
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+func (d Decimal) Add(other Decimal) Decimal {
+	a, b := align(d, other)
+	if a.big == nil && b.big == nil {
+		if coef, ok := add64(a.coef, b.coef); ok {
+			return Decimal{coef: coef, scale: a.scale}
+		}
+	}
+	return newDecimalFromBig(new(big.Int).Add(a.bigCoef(), b.bigCoef()), a.scale)
+}
+
+func (d Decimal) Sub(other Decimal) Decimal {
+	return d.Add(other.Neg())
+}
+
+func (d Decimal) Mul(other Decimal) Decimal {
+	if d.big == nil && other.big == nil {
+		if coef, ok := mul64(d.coef, other.coef); ok {
+			return Decimal{coef: coef, scale: d.scale + other.scale}
+		}
+	}
+	return newDecimalFromBig(new(big.Int).Mul(d.bigCoef(), other.bigCoef()), d.scale+other.scale)
+}
+
+// # Divides with "scale" decimals, rounded with "mode"
+//
+// Returns an error when dividing by zero
+func (d Decimal) Div(other Decimal, scale int32, mode RoundingMode) (Decimal, error) {
+	if other.IsZero() {
+		return Decimal{}, fmt.Errorf("division by zero")
+	}
+
+	// d / other = (d.coef * 10^(scale + other.scale - d.scale)) / other.coef, at "scale"
+	numerator := d.bigCoef()
+	exponent := scale + other.scale - d.scale
+	if exponent >= 0 {
+		numerator = new(big.Int).Mul(numerator, bigPow10(exponent))
+	}
+	denominator := other.bigCoef()
+	if exponent < 0 {
+		denominator = new(big.Int).Mul(denominator, bigPow10(-exponent))
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	quotient = roundQuotient(quotient, remainder, denominator, mode)
+
+	return newDecimalFromBig(quotient, scale), nil
+}
+
+// Adjusts a truncated quotient following "mode"
+func roundQuotient(quotient *big.Int, remainder *big.Int, denominator *big.Int, mode RoundingMode) *big.Int {
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	// Sign of the exact result
+	positive := (remainder.Sign() > 0) == (denominator.Sign() > 0)
+
+	awayFromZero := false
+	switch mode {
+	case ROUND_FLOOR:
+		awayFromZero = !positive
+	case ROUND_CEIL:
+		awayFromZero = positive
+	case ROUND_HALF_UP:
+		doubled := new(big.Int).Abs(remainder)
+		doubled.Lsh(doubled, 1)
+		awayFromZero = doubled.Cmp(new(big.Int).Abs(denominator)) >= 0
+	}
+
+	if !awayFromZero {
+		return quotient
+	}
+	if positive {
+		return quotient.Add(quotient, big.NewInt(1))
+	}
+	return quotient.Sub(quotient, big.NewInt(1))
+}
+
+func (d Decimal) Neg() Decimal {
+	if d.big == nil && d.coef != math.MinInt64 {
+		return Decimal{coef: -d.coef, scale: d.scale}
+	}
+	return newDecimalFromBig(new(big.Int).Neg(d.bigCoef()), d.scale)
+}
+
+func (d Decimal) Abs() Decimal {
+	if d.Sign() < 0 {
+		return d.Neg()
+	}
+	return d
+}
+
+// Returns -1, 0 or 1
+func (d Decimal) Sign() int {
+	if d.big != nil {
+		return d.big.Sign()
+	}
+	switch {
+	case d.coef < 0:
+		return -1
+	case d.coef > 0:
+		return 1
+	}
+	return 0
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Returns -1 if d < other, 0 if d == other, 1 if d > other
+func (d Decimal) Cmp(other Decimal) int {
+	a, b := align(d, other)
+	if a.big == nil && b.big == nil {
+		switch {
+		case a.coef < b.coef:
+			return -1
+		case a.coef > b.coef:
+			return 1
+		}
+		return 0
+	}
+	return a.bigCoef().Cmp(b.bigCoef())
+}
+
+func (d Decimal) Equal(other Decimal) bool       { return d.Cmp(other) == 0 }
+func (d Decimal) LessThan(other Decimal) bool    { return d.Cmp(other) < 0 }
+func (d Decimal) GreaterThan(other Decimal) bool { return d.Cmp(other) > 0 }
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Rounds to "places" decimals
+func (d Decimal) Round(places int32, mode RoundingMode) Decimal {
+	if places >= d.scale {
+		return d
+	}
+	return d.RoundToStep(Decimal{coef: 1, scale: places}.normalizeScale(), mode)
+}
+
+// # Rounds to a multiple of "step", i.e: a tickSize or a stepSize
+//
+// The result has the same scale as "step", a zero step returns d unchanged
+func (d Decimal) RoundToStep(step Decimal, mode RoundingMode) Decimal {
+	if step.IsZero() {
+		return d
+	}
+
+	a, s := align(d, step)
+
+	if a.big == nil && s.big == nil {
+		quotient, remainder := a.coef/s.coef, a.coef%s.coef
+		if remainder != 0 {
+			positive := (remainder > 0) == (s.coef > 0)
+
+			awayFromZero := false
+			switch mode {
+			case ROUND_FLOOR:
+				awayFromZero = !positive
+			case ROUND_CEIL:
+				awayFromZero = positive
+			case ROUND_HALF_UP:
+				absRemainder, absStep := remainder, s.coef
+				if absRemainder < 0 {
+					absRemainder = -absRemainder
+				}
+				if absStep < 0 {
+					absStep = -absStep
+				}
+				awayFromZero = absRemainder >= absStep-absRemainder
+			}
+
+			if awayFromZero && positive {
+				quotient++
+			} else if awayFromZero {
+				quotient--
+			}
+		}
+
+		if step.big == nil {
+			if coef, ok := mul64(quotient, step.coef); ok {
+				return Decimal{coef: coef, scale: step.scale}
+			}
+		}
+		return newDecimalFromBig(new(big.Int).Mul(big.NewInt(quotient), step.bigCoef()), step.scale)
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(a.bigCoef(), s.bigCoef(), new(big.Int))
+	quotient = roundQuotient(quotient, remainder, s.bigCoef(), mode)
+
+	return newDecimalFromBig(quotient.Mul(quotient, step.bigCoef()), step.scale)
+}
+
+// Returns true if d is a multiple of "step", always true for a zero step
+func (d Decimal) IsMultipleOf(step Decimal) bool {
+	if step.IsZero() {
+		return true
+	}
+
+	a, s := align(d, step)
+	if a.big == nil && s.big == nil {
+		return a.coef%s.coef == 0
+	}
+	return new(big.Int).Rem(a.bigCoef(), s.bigCoef()).Sign() == 0
+}
+
+// Number of decimals, trailing zeros excluded, i.e: 2 for "0.01000000", 0 for "10"
+func (d Decimal) Precision() int32 {
+	str := d.String()
+	_, fracPart, found := strings.Cut(str, ".")
+	if !found {
+		return 0
+	}
+	return int32(len(fracPart))
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Returns the shortest exact representation, i.e: "0.01" for "0.01000000"
+func (d Decimal) String() string {
+	digits := strconv.FormatInt(d.coef, 10)
+	if d.big != nil {
+		digits = d.big.String()
+	}
+
+	negative := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(digits, "-")
+
+	if d.scale > 0 {
+		if int32(len(digits)) <= d.scale {
+			digits = strings.Repeat("0", int(d.scale)-len(digits)+1) + digits
+		}
+		pointIndex := len(digits) - int(d.scale)
+		digits = strings.TrimRight(digits[:pointIndex]+"."+digits[pointIndex:], "0")
+		digits = strings.TrimSuffix(digits, ".")
+	}
+
+	if negative && digits != "0" {
+		return "-" + digits
+	}
+	return digits
+}
+
+// # Returns the value with exactly "places" decimals, rounded with ROUND_HALF_UP if needed
+func (d Decimal) StringFixed(places int32) string {
+	rounded := d.Round(places, ROUND_HALF_UP)
+	str := rounded.String()
+	if places <= 0 {
+		return str
+	}
+
+	_, fracPart, found := strings.Cut(str, ".")
+	if !found {
+		return str + "." + strings.Repeat("0", int(places))
+	}
+	return str + strings.Repeat("0", int(places)-len(fracPart))
+}
+
+// Nearest float64, may lose precision
+func (d Decimal) Float64() float64 {
+	value, _ := strconv.ParseFloat(d.String(), 64)
+	return value
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// Accepts both string ("1.5") and number (1.5) values, null and "" are read as 0
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	str := strings.Trim(string(data), `"`)
+	if str == "" || str == "null" {
+		*d = Decimal{}
+		return nil
+	}
+
+	// Exponents are only expected from JSON numbers
+	if strings.ContainsAny(str, "eE") {
+		value, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return err
+		}
+		parsed, err := NewDecimalFromFloat(value)
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	}
+
+	parsed, err := ParseDecimal(str)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(data []byte) error {
+	return d.UnmarshalJSON(data)
+}
+
+// Parses Binance's string numbers, keeping the first error
+type decimalParser struct {
+	err error
+}
+
+func (parser *decimalParser) parse(field string, str string) Decimal {
+	if parser.err != nil {
+		return Decimal{}
+	}
+
+	value, err := ParseDecimal(str)
+	if err != nil {
+		parser.err = fmt.Errorf("cannot parse '%s': %s", field, err.Error())
+	}
+	return value
+}
+
+// Parses the string number at "index" of a raw kline array
+func (parser *decimalParser) kline(raw []interface{}, index int) Decimal {
+	if parser.err != nil {
+		return Decimal{}
+	}
+
+	if index >= len(raw) {
+		parser.err = fmt.Errorf("kline has no value at index %d", index)
+		return Decimal{}
+	}
+	str, isString := raw[index].(string)
+	if !isString {
+		parser.err = fmt.Errorf("kline value at index %d is not a string number: %v", index, raw[index])
+		return Decimal{}
+	}
+
+	return parser.parse(fmt.Sprintf("kline[%d]", index), str)
+}
+
+func maxDecimal(a Decimal, b Decimal) Decimal {
+	if a.GreaterThan(b) {
+		return a
+	}
+	return b
+}
+
+func minDecimal(a Decimal, b Decimal) Decimal {
+	if a.LessThan(b) {
+		return a
+	}
+	return b
+}
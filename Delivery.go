@@ -0,0 +1,1077 @@
+package Binance
+
+import (
+	"fmt"
+	"time"
+)
+
+// # COIN-M Futures
+//
+// Contracts are margined and settled in the base asset, quantities are expressed in contracts (see Delivery_Symbol.ContractSize).
+//
+// Enums are shared with the USDⓈ-M Futures, use FUTURES_Constants.
+type Delivery struct {
+	binance       *Binance
+	requestClient RequestClient
+	baseURL       string
+
+	API APIKEYS
+
+	Websockets Delivery_Websockets
+}
+
+func (delivery *Delivery) init(binance *Binance) {
+	delivery.binance = binance
+
+	delivery.requestClient.init(binance)
+	delivery.requestClient.Set_APIKEY(binance.API.KEY, binance.API.SECRET)
+
+	delivery.baseURL = DELIVERY_Constants.URLs[0]
+
+	delivery.API.Set(binance.API.KEY, binance.API.SECRET)
+
+	delivery.Websockets.binance = binance
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Test connectivity to the Rest API.
+//
+// Weight: 1
+func (delivery *Delivery) Ping() (latency int64, request *Response, err *Error) {
+	startTime := time.Now().UnixMilli()
+	httpResp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/ping",
+	})
+	diff := time.Now().UnixMilli() - startTime
+	if err != nil {
+		return diff, httpResp, err
+	}
+
+	return diff, httpResp, nil
+}
+
+func (delivery *Delivery) ServerTime() (*Futures_Time, *Response, *Error) {
+	var deliveryTime Futures_Time
+
+	startTime := time.Now().UnixMilli()
+	httpResp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/time",
+	})
+	diff := time.Now().UnixMilli() - startTime
+	deliveryTime.Latency = diff
+	if err != nil {
+		return &deliveryTime, httpResp, err
+	}
+
+	processingErr := json.Unmarshal(httpResp.Body, &deliveryTime)
+	if processingErr != nil {
+		return &deliveryTime, httpResp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+
+	return &deliveryTime, httpResp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+//////// ExchangeInfo \\
+
+func (delivery *Delivery) ExchangeInfo() (*Delivery_ExchangeInfo, *Response, *Error) {
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/exchangeInfo",
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	exchangeInfo, err := ParseDeliveryExchangeInfo(resp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return exchangeInfo, resp, nil
+}
+
+func ParseDeliveryExchangeInfo(exchangeInfo_response *Response) (*Delivery_ExchangeInfo, *Error) {
+	var exchangeInfo Delivery_ExchangeInfo
+
+	err := json.Unmarshal(exchangeInfo_response.Body, &exchangeInfo)
+	if err != nil {
+		return nil, LocalError(PARSING_ERR, err.Error())
+	}
+
+	exchangeInfo.Symbols.Map = make(map[string]*Delivery_Symbol)
+	for _, symbol_obj := range exchangeInfo.Symbols_arr {
+		exchangeInfo.Symbols.Map[symbol_obj.Symbol] = symbol_obj
+	}
+
+	return &exchangeInfo, nil
+}
+
+//////// ExchangeInfo //
+/////////////////////////////////////////////////////////////////////////////////
+
+func (delivery *Delivery) OrderBook(symbol string, limit ...int64) (*Delivery_OrderBook, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if len(limit) != 0 {
+		opts["limit"] = limit[0]
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/depth",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var orderBook Delivery_OrderBook
+
+	unmarshallErr := json.Unmarshal(resp.Body, &orderBook)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return &orderBook, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+func (delivery *Delivery) Trades(symbol string, limit ...int64) ([]*Delivery_Trade, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if len(limit) != 0 {
+		opts["limit"] = limit[0]
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/trades",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var trades []*Delivery_Trade
+
+	unmarshallErr := json.Unmarshal(resp.Body, &trades)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return trades, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+func (delivery *Delivery) HistoricalTrades(symbol string, opt_params ...Futures_HistoricalTrades_Params) ([]*Delivery_Trade, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if params.Limit != 0 {
+			opts["limit"] = params.Limit
+		}
+		if params.FromId != 0 {
+			opts["fromId"] = params.FromId
+		}
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.MARKET_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/historicalTrades",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var historicalTrades []*Delivery_Trade
+
+	unmarshallErr := json.Unmarshal(resp.Body, &historicalTrades)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return historicalTrades, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+func (delivery *Delivery) AggTrades(symbol string, opt_params ...Futures_AggTrade_Params) ([]*Futures_AggTrade, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if params.FromId != 0 {
+			opts["fromId"] = params.FromId
+		}
+		if params.StartTime != 0 {
+			opts["startTime"] = params.StartTime
+		}
+		if params.EndTime != 0 {
+			opts["endTime"] = params.EndTime
+		}
+		if params.Limit != 0 {
+			opts["limit"] = params.Limit
+		}
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/aggTrades",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var aggTrades []*Futures_AggTrade
+
+	unmarshallErr := json.Unmarshal(resp.Body, &aggTrades)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return aggTrades, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+func (delivery *Delivery) Candlesticks(symbol string, interval string, opt_params ...Futures_Candlesticks_Params) ([]*Delivery_Candlestick, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	opts["interval"] = interval
+
+	return delivery.candlesticks("/dapi/v1/klines", opts, opt_params...)
+}
+
+// # Kline/candlestick bars for a specific contract type.
+//
+// Klines are uniquely identified by their open time.
+//
+// Contract Types:
+// "PERPETUAL" | "CURRENT_QUARTER" | "NEXT_QUARTER"
+func (delivery *Delivery) ContinuousContractCandlesticks(pair string, contractType string, interval string, opt_params ...Futures_Candlesticks_Params) ([]*Delivery_Candlestick, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["pair"] = pair
+	opts["contractType"] = contractType
+	opts["interval"] = interval
+
+	return delivery.candlesticks("/dapi/v1/continuousKlines", opts, opt_params...)
+}
+
+func (delivery *Delivery) candlesticks(url string, opts map[string]interface{}, opt_params ...Futures_Candlesticks_Params) ([]*Delivery_Candlestick, *Response, *Error) {
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if params.Limit != 0 {
+			opts["limit"] = params.Limit
+		}
+		if params.StartTime != 0 {
+			opts["startTime"] = params.StartTime
+		}
+		if params.EndTime != 0 {
+			opts["endTime"] = params.EndTime
+		}
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          url,
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// Unmarshal the data
+	var rawCandlesticks [][]interface{}
+	processingErr := json.Unmarshal(resp.Body, &rawCandlesticks)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+
+	// Convert the raw data to Delivery_Candlestick slice
+	candlesticks := make([]*Delivery_Candlestick, len(rawCandlesticks))
+	for i, raw := range rawCandlesticks {
+		var parser decimalParser
+		candlesticks[i] = &Delivery_Candlestick{
+			OpenTime:                int64(raw[0].(float64)),
+			Open:                    parser.kline(raw, 1),
+			High:                    parser.kline(raw, 2),
+			Low:                     parser.kline(raw, 3),
+			Close:                   parser.kline(raw, 4),
+			Volume:                  parser.kline(raw, 5),
+			CloseTime:               int64(raw[6].(float64)),
+			BaseAssetVolume:         parser.kline(raw, 7),
+			TradeCount:              int64(raw[8].(float64)),
+			TakerBuyVolume:          parser.kline(raw, 9),
+			TakerBuyBaseAssetVolume: parser.kline(raw, 10),
+			Unused:                  raw[11].(string),
+		}
+		if parser.err != nil {
+			return nil, resp, LocalError(PARSING_ERR, parser.err.Error())
+		}
+	}
+
+	return candlesticks, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+func (delivery *Delivery) IndexPriceCandlesticks(pair string, interval string, opt_params ...Futures_PriceCandlesticks_Params) ([]*Futures_PriceCandlestick, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["pair"] = pair
+	opts["interval"] = interval
+
+	return delivery.priceCandlesticks("/dapi/v1/indexPriceKlines", opts, opt_params...)
+}
+
+func (delivery *Delivery) MarkPriceCandlesticks(symbol string, interval string, opt_params ...Futures_PriceCandlesticks_Params) ([]*Futures_PriceCandlestick, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	opts["interval"] = interval
+
+	return delivery.priceCandlesticks("/dapi/v1/markPriceKlines", opts, opt_params...)
+}
+
+func (delivery *Delivery) priceCandlesticks(url string, opts map[string]interface{}, opt_params ...Futures_PriceCandlesticks_Params) ([]*Futures_PriceCandlestick, *Response, *Error) {
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if params.Limit != 0 {
+			opts["limit"] = params.Limit
+		}
+		if params.StartTime != 0 {
+			opts["startTime"] = params.StartTime
+		}
+		if params.EndTime != 0 {
+			opts["endTime"] = params.EndTime
+		}
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          url,
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// Unmarshal the data
+	var rawCandlesticks [][]interface{}
+	processingErr := json.Unmarshal(resp.Body, &rawCandlesticks)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+
+	// Convert the raw data to Futures_PriceCandlestick slice
+	candlesticks := make([]*Futures_PriceCandlestick, len(rawCandlesticks))
+	for i, raw := range rawCandlesticks {
+		var parser decimalParser
+		candlesticks[i] = &Futures_PriceCandlestick{
+			OpenTime:  int64(raw[0].(float64)),
+			Open:      parser.kline(raw, 1),
+			High:      parser.kline(raw, 2),
+			Low:       parser.kline(raw, 3),
+			Close:     parser.kline(raw, 4),
+			Ignore1:   raw[5].(string),
+			CloseTime: int64(raw[6].(float64)),
+			Ignore2:   raw[7].(string),
+			Ignore3:   int64(raw[8].(float64)),
+			Ignore4:   raw[9].(string),
+			Ignore5:   raw[10].(string),
+			Unused:    raw[11].(string),
+		}
+		if parser.err != nil {
+			return nil, resp, LocalError(PARSING_ERR, parser.err.Error())
+		}
+	}
+
+	return candlesticks, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Delivery_MarkPrice_Params struct {
+	Symbol string
+	Pair   string
+}
+
+// Always returns an array, filtered by symbol or pair if sent
+func (delivery *Delivery) MarkPrice(opt_params ...Delivery_MarkPrice_Params) ([]*Delivery_MarkPrice, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Symbol) {
+			opts["symbol"] = params.Symbol
+		}
+		if IsDifferentFromDefault(params.Pair) {
+			opts["pair"] = params.Pair
+		}
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/premiumIndex",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var markPrices []*Delivery_MarkPrice
+
+	unmarshallErr := json.Unmarshal(resp.Body, &markPrices)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return markPrices, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Delivery_FundingRate_Params struct {
+	StartTime int64
+	EndTime   int64
+	Limit     int64
+}
+
+// Funding rates only exist for perpetual contracts, the symbol is mandatory
+func (delivery *Delivery) FundingRateHistory(symbol string, opt_params ...Delivery_FundingRate_Params) ([]*Futures_FundingRate, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/fundingRate",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var fundingRates []*Futures_FundingRate
+
+	unmarshallErr := json.Unmarshal(resp.Body, &fundingRates)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return fundingRates, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Delivery_Ticker_Params struct {
+	Symbol string
+	Pair   string
+}
+
+// Always returns an array, filtered by symbol or pair if sent
+func (delivery *Delivery) Ticker24h(opt_params ...Delivery_Ticker_Params) ([]*Delivery_24hTicker, *Response, *Error) {
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/ticker/24hr",
+		params:       createDeliveryTickerOpts(opt_params...),
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var tickers []*Delivery_24hTicker
+	unmarshallErr := json.Unmarshal(resp.Body, &tickers)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return tickers, resp, nil
+}
+
+// Always returns an array, filtered by symbol or pair if sent
+func (delivery *Delivery) PriceTicker(opt_params ...Delivery_Ticker_Params) ([]*Delivery_PriceTicker, *Response, *Error) {
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/ticker/price",
+		params:       createDeliveryTickerOpts(opt_params...),
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var priceTickers []*Delivery_PriceTicker
+	unmarshallErr := json.Unmarshal(resp.Body, &priceTickers)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return priceTickers, resp, nil
+}
+
+// Always returns an array, filtered by symbol or pair if sent
+func (delivery *Delivery) BookTicker(opt_params ...Delivery_Ticker_Params) ([]*Delivery_BookTicker, *Response, *Error) {
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/ticker/bookTicker",
+		params:       createDeliveryTickerOpts(opt_params...),
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var bookTickers []*Delivery_BookTicker
+	unmarshallErr := json.Unmarshal(resp.Body, &bookTickers)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return bookTickers, resp, nil
+}
+
+func createDeliveryTickerOpts(opt_params ...Delivery_Ticker_Params) map[string]interface{} {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Symbol) {
+			opts["symbol"] = params.Symbol
+		}
+		if IsDifferentFromDefault(params.Pair) {
+			opts["pair"] = params.Pair
+		}
+	}
+
+	return opts
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+func (delivery *Delivery) OpenInterest(symbol string) (*Delivery_OpenInterest, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["symbol"] = symbol
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/openInterest",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var openInterest *Delivery_OpenInterest
+	unmarshallErr := json.Unmarshal(resp.Body, &openInterest)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return openInterest, resp, nil
+}
+
+// //////////////////////////// Orders \\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\
+
+func (delivery *Delivery) newOrder(opts map[string]interface{}) (*Delivery_Order, *Response, *Error) {
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.TRADE,
+		method:       Constants.Methods.POST,
+		url:          "/dapi/v1/order",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var order *Delivery_Order
+	processingErr := json.Unmarshal(resp.Body, &order)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return order, resp, nil
+}
+
+// "Quantity" is expressed in contracts
+//
+// "GoodTillDate" isn't supported by COIN-M Futures and is ignored
+func (delivery *Delivery) NewOrder(symbol string, side string, Type string, opt_params ...Futures_Order_Params) (*Delivery_Order, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	opts["side"] = side
+	opts["type"] = Type
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.PositionSide) {
+			opts["positionSide"] = params.PositionSide
+		}
+		if IsDifferentFromDefault(params.TimeInForce) {
+			opts["timeInForce"] = params.TimeInForce
+		}
+		if IsDifferentFromDefault(params.Quantity) {
+			opts["quantity"] = params.Quantity
+		}
+		if IsDifferentFromDefault(params.ReduceOnly) {
+			opts["reduceOnly"] = params.ReduceOnly
+		}
+		if IsDifferentFromDefault(params.Price) {
+			opts["price"] = params.Price
+		}
+		if IsDifferentFromDefault(params.NewClientOrderId) {
+			opts["newClientOrderId"] = params.NewClientOrderId
+		}
+		if IsDifferentFromDefault(params.StopPrice) {
+			opts["stopPrice"] = params.StopPrice
+		}
+		if IsDifferentFromDefault(params.ClosePosition) {
+			opts["closePosition"] = params.ClosePosition
+		}
+		if IsDifferentFromDefault(params.ActivationPrice) {
+			opts["activationPrice"] = params.ActivationPrice
+		}
+		if IsDifferentFromDefault(params.CallbackRate) {
+			opts["callbackRate"] = params.CallbackRate
+		}
+		if IsDifferentFromDefault(params.WorkingType) {
+			opts["workingType"] = params.WorkingType
+		}
+		if IsDifferentFromDefault(params.PriceProtect) {
+			opts["priceProtect"] = params.PriceProtect
+		}
+		if IsDifferentFromDefault(params.NewOrderRespType) {
+			opts["newOrderRespType"] = params.NewOrderRespType
+		}
+		if IsDifferentFromDefault(params.PriceMatch) {
+			opts["priceMatch"] = params.PriceMatch
+		}
+		if IsDifferentFromDefault(params.SelfTradePreventionMode) {
+			opts["selfTradePreventionMode"] = params.SelfTradePreventionMode
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	return delivery.newOrder(opts)
+}
+
+///////////////////////// LIMIT \\\\\\\\\\\\\\\\\\\\\\\\\\\\
+
+// "quantity" is expressed in contracts
+//
+// "GoodTillDate" isn't supported by COIN-M Futures and is ignored
+func (delivery *Delivery) LimitOrder(symbol string, side string, price string, quantity string, timeInForce string, opt_params ...Futures_LimitOrder_Params) (*Delivery_Order, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	opts["side"] = side
+	opts["type"] = "LIMIT"
+	opts["price"] = price
+	opts["quantity"] = quantity
+	opts["timeInForce"] = timeInForce
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.PositionSide) {
+			opts["positionSide"] = params.PositionSide
+		}
+		if IsDifferentFromDefault(params.ReduceOnly) {
+			opts["reduceOnly"] = params.ReduceOnly
+		}
+		if IsDifferentFromDefault(params.NewClientOrderId) {
+			opts["newClientOrderId"] = params.NewClientOrderId
+		}
+		if IsDifferentFromDefault(params.WorkingType) {
+			opts["workingType"] = params.WorkingType
+		}
+		if IsDifferentFromDefault(params.NewOrderRespType) {
+			opts["newOrderRespType"] = params.NewOrderRespType
+		}
+		if IsDifferentFromDefault(params.PriceMatch) {
+			opts["priceMatch"] = params.PriceMatch
+			delete(opts, "price")
+		}
+		if IsDifferentFromDefault(params.SelfTradePreventionMode) {
+			opts["selfTradePreventionMode"] = params.SelfTradePreventionMode
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	return delivery.newOrder(opts)
+}
+
+func (delivery *Delivery) LimitBuy(symbol string, price string, quantity string, timeInForce string, opt_params ...Futures_LimitOrder_Params) (*Delivery_Order, *Response, *Error) {
+	return delivery.LimitOrder(symbol, "BUY", price, quantity, timeInForce, opt_params...)
+}
+
+func (delivery *Delivery) LimitSell(symbol string, price string, quantity string, timeInForce string, opt_params ...Futures_LimitOrder_Params) (*Delivery_Order, *Response, *Error) {
+	return delivery.LimitOrder(symbol, "SELL", price, quantity, timeInForce, opt_params...)
+}
+
+///////////////////////// LIMIT ////////////////////////////
+
+///////////////////////// MARKET \\\\\\\\\\\\\\\\\\\\\\\\\\\
+
+// "quantity" is expressed in contracts
+func (delivery *Delivery) MarketOrder(symbol string, side string, quantity string, opt_params ...Futures_MarketOrder_Params) (*Delivery_Order, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	opts["side"] = side
+	opts["type"] = "MARKET"
+	opts["quantity"] = quantity
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.PositionSide) {
+			opts["positionSide"] = params.PositionSide
+		}
+		if IsDifferentFromDefault(params.ReduceOnly) {
+			opts["reduceOnly"] = params.ReduceOnly
+		}
+		if IsDifferentFromDefault(params.NewClientOrderId) {
+			opts["newClientOrderId"] = params.NewClientOrderId
+		}
+		if IsDifferentFromDefault(params.WorkingType) {
+			opts["workingType"] = params.WorkingType
+		}
+		if IsDifferentFromDefault(params.NewOrderRespType) {
+			opts["newOrderRespType"] = params.NewOrderRespType
+		}
+		if IsDifferentFromDefault(params.SelfTradePreventionMode) {
+			opts["selfTradePreventionMode"] = params.SelfTradePreventionMode
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	return delivery.newOrder(opts)
+}
+
+func (delivery *Delivery) MarketBuy(symbol string, quantity string, opt_params ...Futures_MarketOrder_Params) (*Delivery_Order, *Response, *Error) {
+	return delivery.MarketOrder(symbol, "BUY", quantity, opt_params...)
+}
+
+func (delivery *Delivery) MarketSell(symbol string, quantity string, opt_params ...Futures_MarketOrder_Params) (*Delivery_Order, *Response, *Error) {
+	return delivery.MarketOrder(symbol, "SELL", quantity, opt_params...)
+}
+
+///////////////////////// MARKET ///////////////////////////
+
+type Delivery_OrderIdentifier_Params struct {
+	OrderId           int64
+	OrigClientOrderId string
+	RecvWindow        int64
+}
+
+// Either "OrderId" or "OrigClientOrderId" must be sent
+func (delivery *Delivery) QueryOrder(symbol string, params Delivery_OrderIdentifier_Params) (*Delivery_Order, *Response, *Error) {
+	return delivery.orderIdentifierRequest(Constants.Methods.GET, symbol, params)
+}
+
+// Either "OrderId" or "OrigClientOrderId" must be sent
+func (delivery *Delivery) CancelOrder(symbol string, params Delivery_OrderIdentifier_Params) (*Delivery_Order, *Response, *Error) {
+	return delivery.orderIdentifierRequest(Constants.Methods.DELETE, symbol, params)
+}
+
+func (delivery *Delivery) orderIdentifierRequest(method string, symbol string, params Delivery_OrderIdentifier_Params) (*Delivery_Order, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if IsDifferentFromDefault(params.OrderId) {
+		opts["orderId"] = params.OrderId
+	}
+	if IsDifferentFromDefault(params.OrigClientOrderId) {
+		opts["origClientOrderId"] = params.OrigClientOrderId
+	}
+	if IsDifferentFromDefault(params.RecvWindow) {
+		opts["recvWindow"] = params.RecvWindow
+	}
+
+	securityType := FUTURES_Constants.SecurityTypes.TRADE
+	if method == Constants.Methods.GET {
+		securityType = FUTURES_Constants.SecurityTypes.USER_DATA
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: securityType,
+		method:       method,
+		url:          "/dapi/v1/order",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var order *Delivery_Order
+	processingErr := json.Unmarshal(resp.Body, &order)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return order, resp, nil
+}
+
+// \\\\\\\\\\\\\\\\\\\\\\\\\\\ Orders ////////////////////////////////////////
+
+// Margin Types:
+//
+// - "ISOLATED"
+//
+// - "CROSSED"
+func (delivery *Delivery) ChangeMarginType(symbol string, marginType string, recvWindow ...int64) (*Futures_ChangeMarginType_Response, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	opts["marginType"] = marginType
+
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.TRADE,
+		method:       Constants.Methods.POST,
+		url:          "/dapi/v1/marginType",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var response *Futures_ChangeMarginType_Response
+	processingErr := json.Unmarshal(resp.Body, &response)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return response, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+func (delivery *Delivery) ChangePositionMode(toHedgeMode bool, recvWindow ...int64) (*Futures_ChangePositionMode_Response, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["dualSidePosition"] = toHedgeMode
+
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.TRADE,
+		method:       Constants.Methods.POST,
+		url:          "/dapi/v1/positionSide/dual",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var response *Futures_ChangePositionMode_Response
+	processingErr := json.Unmarshal(resp.Body, &response)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return response, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+func (delivery *Delivery) ChangeInitialLeverage(symbol string, leverage int, recvWindow ...int64) (*Delivery_ChangeInitialLeverage_Response, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	opts["leverage"] = leverage
+
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.TRADE,
+		method:       Constants.Methods.POST,
+		url:          "/dapi/v1/leverage",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var response *Delivery_ChangeInitialLeverage_Response
+	processingErr := json.Unmarshal(resp.Body, &response)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return response, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+func (delivery *Delivery) AccountInfo(recvWindow ...int64) (*Delivery_AccountInfo, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/dapi/v1/account",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var accountInfo *Delivery_AccountInfo
+	processingErr := json.Unmarshal(resp.Body, &accountInfo)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return accountInfo, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Start User Data Stream
+//
+// Start a new user data stream. The stream will close after 60 minutes unless a keepalive is sent.
+//
+// If the account has an active listenKey, that listenKey will be returned and its validity will be extended for 60 minutes.
+//
+// Weight: 1
+func (delivery *Delivery) CreateListenKey() (*Futures_ListenKey, *Response, *Error) {
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.USER_STREAM,
+		method:       Constants.Methods.POST,
+		url:          "/dapi/v1/listenKey",
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var listenKey *Futures_ListenKey
+	processingErr := json.Unmarshal(resp.Body, &listenKey)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return listenKey, resp, nil
+}
+
+// # Keepalive User Data Stream
+//
+// Keepalive a user data stream to prevent a time out. User data streams will close after 60 minutes.
+//
+// Weight: 1
+func (delivery *Delivery) KeepAliveListenKey() (*Futures_ListenKey, *Response, *Error) {
+	resp, err := delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.USER_STREAM,
+		method:       Constants.Methods.PUT,
+		url:          "/dapi/v1/listenKey",
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var listenKey *Futures_ListenKey
+	processingErr := json.Unmarshal(resp.Body, &listenKey)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return listenKey, resp, nil
+}
+
+// # Close User Data Stream
+//
+// Close out a user data stream.
+//
+// Weight: 1
+func (delivery *Delivery) CloseListenKey() (*Response, *Error) {
+	return delivery.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.USER_STREAM,
+		method:       Constants.Methods.DELETE,
+		url:          "/dapi/v1/listenKey",
+	})
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+func (delivery *Delivery) makeRequest(request *FuturesRequest) (*Response, *Error) {
+
+	switch request.securityType {
+	case FUTURES_Constants.SecurityTypes.NONE:
+		return delivery.requestClient.Unsigned(request.method, delivery.baseURL, request.url, request.params)
+	case FUTURES_Constants.SecurityTypes.MARKET_DATA:
+		return delivery.requestClient.APIKEY_only(request.method, delivery.baseURL, request.url, request.params)
+	case FUTURES_Constants.SecurityTypes.USER_STREAM:
+		return delivery.requestClient.APIKEY_only(request.method, delivery.baseURL, request.url, request.params)
+
+	case FUTURES_Constants.SecurityTypes.TRADE:
+		return delivery.requestClient.Signed(request.method, delivery.baseURL, request.url, request.params)
+	case FUTURES_Constants.SecurityTypes.USER_DATA:
+		return delivery.requestClient.Signed(request.method, delivery.baseURL, request.url, request.params)
+
+	default:
+		panic(fmt.Sprintf("Security Type passed to Request function is invalid, received: '%s'\nSupported methods are ('%s', '%s', '%s', '%s')", request.securityType, FUTURES_Constants.SecurityTypes.NONE, FUTURES_Constants.SecurityTypes.USER_STREAM, FUTURES_Constants.SecurityTypes.TRADE, FUTURES_Constants.SecurityTypes.USER_DATA))
+	}
+
+}
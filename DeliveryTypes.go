@@ -0,0 +1,324 @@
+package Binance
+
+import (
+	"sync"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// COIN-M Futures share their enums (order types, contract types, security types...) with FUTURES_Constants
+var DELIVERY_Constants = struct {
+	URLs [1]string
+
+	Websocket Futures_Websocket_Constants
+}{
+	URLs: [1]string{"https://dapi.binance.com"},
+	Websocket: Futures_Websocket_Constants{
+		URLs:                             []string{"wss://dstream.binance.com"},
+		LISTENKEY_KEEPALIVE_INTERVAL_SEC: (30 * MINUTE) / SECOND,
+	},
+}
+
+type Delivery_Symbol struct {
+	Symbol         string `json:"symbol"`
+	Pair           string `json:"pair"`
+	ContractType   string `json:"contractType"`
+	DeliveryDate   int64  `json:"deliveryDate"`
+	OnboardDate    int64  `json:"onboardDate"`
+	ContractStatus string `json:"contractStatus"`
+	// Value of a single contract, in USD
+	ContractSize int64  `json:"contractSize"`
+	MarginAsset  string `json:"marginAsset"`
+	// ignore
+	MaintMarginPercent string `json:"maintMarginPercent"`
+	// ignore
+	RequiredMarginPercent string `json:"requiredMarginPercent"`
+	BaseAsset             string `json:"baseAsset"`
+	QuoteAsset            string `json:"quoteAsset"`
+	PricePrecision        int64  `json:"pricePrecision"`
+	// Quantities are expressed in contracts
+	QuantityPrecision  int64    `json:"quantityPrecision"`
+	BaseAssetPrecision int64    `json:"baseAssetPrecision"`
+	QuotePrecision     int64    `json:"quotePrecision"`
+	EqualQtyPrecision  int64    `json:"equalQtyPrecision"`
+	UnderlyingType     string   `json:"underlyingType"`
+	UnderlyingSubType  []string `json:"underlyingSubType"`
+	TriggerProtect     string   `json:"triggerProtect"`
+	Filters            Futures_SymbolFilters
+	OrderTypes         []string `json:"orderTypes"`
+	TimeInForce        []string `json:"timeInForce"`
+	LiquidationFee     string   `json:"liquidationFee"`
+	MarketTakeBound    string   `json:"marketTakeBound"`
+}
+
+func (symbol *Delivery_Symbol) UnmarshalJSON(data []byte) error {
+	type Alias Delivery_Symbol
+
+	aux := &struct {
+		Filters []jsoniter.RawMessage `json:"filters"`
+		*Alias
+	}{
+		Alias: (*Alias)(symbol),
+	}
+
+	err := json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	symbol.Filters.parse(symbol.Symbol, aux.Filters)
+
+	return nil
+}
+
+type Delivery_ExchangeInfo struct {
+	// Not used by binance
+	ExchangeFilters any                      `json:"exchangeFilters"`
+	RateLimits      []*Futures_RateLimitType `json:"rateLimits"`
+	ServerTime      int64                    `json:"serverTime"`
+	Symbols_arr     []*Delivery_Symbol       `json:"symbols"`
+	Timezone        string                   `json:"timezone"`
+
+	Symbols struct {
+		Mu  sync.Mutex
+		Map map[string]*Delivery_Symbol
+	}
+}
+
+type Delivery_OrderBook struct {
+	LastUpdateId int64        `json:"lastUpdateId"`
+	Symbol       string       `json:"symbol"`
+	Pair         string       `json:"pair"`
+	Time         int64        `json:"E"`
+	TransactTime int64        `json:"T"`
+	Bids         [][2]Decimal `json:"bids"`
+	Asks         [][2]Decimal `json:"asks"`
+}
+
+type Delivery_Trade struct {
+	Id    int64   `json:"id"`
+	Price Decimal `json:"price"`
+	// In contracts
+	Qty Decimal `json:"qty"`
+	// Quantity expressed in the base asset
+	BaseQty      Decimal `json:"baseQty"`
+	Timestamp    int64   `json:"time"`
+	IsBuyerMaker bool    `json:"isBuyerMaker"`
+}
+
+type Delivery_Candlestick struct {
+	// Kline open time
+	OpenTime int64
+	// Open price
+	Open Decimal
+	// High price
+	High Decimal
+	// Low price
+	Low Decimal
+	// Close price
+	Close Decimal
+	// Volume, in contracts
+	Volume Decimal
+	// Kline Close time
+	CloseTime int64
+	// Base asset volume
+	BaseAssetVolume Decimal
+	// Number of trades
+	TradeCount int64
+	// Taker buy volume, in contracts
+	TakerBuyVolume Decimal
+	// Taker buy base asset volume
+	TakerBuyBaseAssetVolume Decimal
+	// Unused field, ignore.
+	Unused string
+}
+
+type Delivery_MarkPrice struct {
+	Symbol               string  `json:"symbol"`
+	Pair                 string  `json:"pair"`
+	MarkPrice            Decimal `json:"markPrice"`
+	IndexPrice           Decimal `json:"indexPrice"`
+	EstimatedSettlePrice Decimal `json:"estimatedSettlePrice"`
+	// Empty for delivery contracts
+	LastFundingRate string `json:"lastFundingRate"`
+	// Empty for delivery contracts
+	InterestRate string `json:"interestRate"`
+	// 0 for delivery contracts
+	NextFundingTime int64 `json:"nextFundingTime"`
+	Time            int64 `json:"time"`
+}
+
+type Delivery_24hTicker struct {
+	Symbol             string  `json:"symbol"`
+	Pair               string  `json:"pair"`
+	PriceChange        Decimal `json:"priceChange"`
+	PriceChangePercent Decimal `json:"priceChangePercent"`
+	WeightedAvgPrice   Decimal `json:"weightedAvgPrice"`
+	LastPrice          Decimal `json:"lastPrice"`
+	LastQty            Decimal `json:"lastQty"`
+	Open               string  `json:"openPrice"`
+	High               string  `json:"highPrice"`
+	Low                string  `json:"lowPrice"`
+	// In contracts
+	Volume     Decimal `json:"volume"`
+	BaseVolume Decimal `json:"baseVolume"`
+	OpenTime   int64   `json:"openTime"`
+	CloseTime  int64   `json:"closeTime"`
+	FirstId    int64   `json:"firstId"`
+	LastId     int64   `json:"lastId"`
+	Count      int64   `json:"count"`
+}
+
+type Delivery_PriceTicker struct {
+	Symbol string  `json:"symbol"`
+	Pair   string  `json:"ps"`
+	Price  Decimal `json:"price"`
+	Time   int64   `json:"time"`
+}
+
+type Delivery_BookTicker struct {
+	Symbol   string  `json:"symbol"`
+	Pair     string  `json:"pair"`
+	BidPrice Decimal `json:"bidPrice"`
+	BidQty   Decimal `json:"bidQty"`
+	AskPrice Decimal `json:"askPrice"`
+	AskQty   Decimal `json:"askQty"`
+	Time     int64   `json:"time"`
+}
+
+type Delivery_OpenInterest struct {
+	Symbol       string `json:"symbol"`
+	Pair         string `json:"pair"`
+	ContractType string `json:"contractType"`
+	// In contracts
+	OpenInterest string `json:"openInterest"`
+	Time         int64  `json:"time"`
+}
+
+//////////////////////////////////////
+//////////////////////////////////////
+//////////////////////////////////////
+
+type Delivery_Order struct {
+	ClientOrderId string `json:"clientOrderId"`
+
+	// In contracts
+	CumQty Decimal `json:"cumQty"`
+
+	// Executed quantity expressed in the base asset
+	CumBase string `json:"cumBase"`
+
+	// In contracts
+	ExecutedQty Decimal `json:"executedQty"`
+
+	OrderId int64 `json:"orderId"`
+
+	AvgPrice Decimal `json:"avgPrice"`
+
+	// In contracts
+	OrigQty Decimal `json:"origQty"`
+
+	Price Decimal `json:"price"`
+
+	ReduceOnly bool `json:"reduceOnly"`
+
+	Side string `json:"side"`
+
+	PositionSide string `json:"positionSide"`
+
+	Status string `json:"status"`
+
+	// please ignore when order type is "TRAILING_STOP_MARKET"
+	StopPrice Decimal `json:"stopPrice"`
+
+	// if Close-All
+	ClosePosition bool `json:"closePosition"`
+
+	Symbol string `json:"symbol"`
+
+	Pair string `json:"pair"`
+
+	TimeInForce string `json:"timeInForce"`
+
+	Type string `json:"type"`
+
+	OrigType string `json:"origType"`
+
+	// activation price, only return with "TRAILING_STOP_MARKET" order
+	ActivatePrice Decimal `json:"activatePrice"`
+
+	// callback rate, only return with "TRAILING_STOP_MARKET" order
+	PriceRate Decimal `json:"priceRate"`
+
+	// Only returned when querying an order
+	Time int64 `json:"time"`
+
+	UpdateTime int64 `json:"updateTime"`
+
+	WorkingType string `json:"workingType"`
+
+	// if conditional order trigger is protected
+	PriceProtect bool `json:"priceProtect"`
+
+	// price match mode
+	PriceMatch string `json:"priceMatch"`
+
+	// self trading preventation mode
+	SelfTradePreventionMode string `json:"selfTradePreventionMode"`
+}
+
+type Delivery_ChangeInitialLeverage_Response struct {
+	Symbol   string `json:"symbol"`
+	Leverage int64  `json:"leverage"`
+	// Expressed in the base asset
+	MaxQty Decimal `json:"maxQty"`
+}
+
+//////////////////////////////////////
+//////////////////////////////////////
+//////////////////////////////////////
+
+type Delivery_AccountInfo struct {
+	Assets      []*Delivery_AccountInfo_Asset    `json:"assets"`
+	Positions   []*Delivery_AccountInfo_Position `json:"positions"`
+	CanDeposit  bool                             `json:"canDeposit"`
+	CanTrade    bool                             `json:"canTrade"`
+	CanWithdraw bool                             `json:"canWithdraw"`
+	FeeTier     int64                            `json:"feeTier"`
+	UpdateTime  int64                            `json:"updateTime"`
+}
+
+type Delivery_AccountInfo_Asset struct {
+	Asset                  string `json:"asset"`
+	WalletBalance          string `json:"walletBalance"`
+	UnrealizedProfit       string `json:"unrealizedProfit"`
+	MarginBalance          string `json:"marginBalance"`
+	MaintMargin            string `json:"maintMargin"`
+	InitialMargin          string `json:"initialMargin"`
+	PositionInitialMargin  string `json:"positionInitialMargin"`
+	OpenOrderInitialMargin string `json:"openOrderInitialMargin"`
+	MaxWithdrawAmount      string `json:"maxWithdrawAmount"`
+	CrossWalletBalance     string `json:"crossWalletBalance"`
+	CrossUnPnl             string `json:"crossUnPnl"`
+	AvailableBalance       string `json:"availableBalance"`
+	UpdateTime             int64  `json:"updateTime"`
+}
+
+type Delivery_AccountInfo_Position struct {
+	Symbol       string `json:"symbol"`
+	PositionSide string `json:"positionSide"`
+	// In contracts
+	PositionAmt            string  `json:"positionAmt"`
+	InitialMargin          string  `json:"initialMargin"`
+	MaintMargin            string  `json:"maintMargin"`
+	UnrealizedProfit       string  `json:"unrealizedProfit"`
+	PositionInitialMargin  string  `json:"positionInitialMargin"`
+	OpenOrderInitialMargin string  `json:"openOrderInitialMargin"`
+	Leverage               string  `json:"leverage"`
+	Isolated               bool    `json:"isolated"`
+	EntryPrice             Decimal `json:"entryPrice"`
+	BreakEvenPrice         Decimal `json:"breakEvenPrice"`
+	// Maximum quantity of base asset
+	MaxQty     Decimal `json:"maxQty"`
+	UpdateTime int64   `json:"updateTime"`
+}
@@ -0,0 +1,177 @@
+package Binance
+
+// COIN-M websockets use the same protocol and stream names as the USDⓈ-M ones
+//
+// The sockets returned here are the Futures sockets, connected to the COIN-M host
+type Delivery_Websockets struct {
+	binance *Binance
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Quantities are expressed in contracts
+func (delivery_ws *Delivery_Websockets) AggTrade(publicOnMessage func(aggTrade *FuturesWS_AggTrade), symbol ...string) (*FuturesWS_AggTrade_Socket, *Error) {
+	var newSocket FuturesWS_AggTrade_Socket
+
+	streamNames := newSocket.CreateStreamName(symbol...)
+
+	socket, err := delivery_ws.CreateSocket(streamNames, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		var aggTrade FuturesWS_AggTrade
+		err := json.Unmarshal(msg, &aggTrade)
+		if err != nil {
+			socket.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		publicOnMessage(&aggTrade)
+	})
+
+	newSocket.Handler = socket
+	return &newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type DeliveryWS_Candlestick struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// Symbol
+	Symbol string `json:"s"`
+
+	Kline *DeliveryWS_Candlestick_Kline `json:"k"`
+}
+type DeliveryWS_Candlestick_Kline struct {
+
+	// Symbol
+	Symbol string `json:"s"`
+
+	// Kline start time
+	OpenTime int64 `json:"t"`
+
+	// Kline close time
+	CloseTime int64 `json:"T"`
+
+	// Is this kline closed?
+	IsClosed bool `json:"x"`
+
+	// Interval
+	Interval string `json:"i"`
+
+	// First trade ID
+	FirstTradeId int64 `json:"f"`
+
+	// Last trade ID
+	LastTradeId int64 `json:"L"`
+
+	// Open price
+	Open string `json:"o"`
+
+	// Close price
+	Close string `json:"c"`
+
+	// High price
+	High string `json:"h"`
+
+	// Low price
+	Low string `json:"l"`
+
+	// Number of trades
+	TradeCount int64 `json:"n"`
+
+	// Volume, in contracts
+	Volume string `json:"v"`
+
+	// Base asset volume
+	BaseAssetVolume string `json:"q"`
+
+	// Taker buy volume, in contracts
+	TakerBuyVolume string `json:"V"`
+
+	// Taker buy base asset volume
+	TakerBuyBaseAssetVolume string `json:"Q"`
+
+	// Ignore
+	Ignore string `json:"B"`
+}
+
+func (delivery_ws *Delivery_Websockets) Candlesticks(publicOnMessage func(candlestick *DeliveryWS_Candlestick), params ...FuturesWS_Candlestick_Params) (*FuturesWS_Candlesticks_Socket, *Error) {
+	var newSocket FuturesWS_Candlesticks_Socket
+
+	streamNames := newSocket.CreateStreamName(params...)
+
+	socket, err := delivery_ws.CreateSocket(streamNames, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		var kline *DeliveryWS_Candlestick
+		err := json.Unmarshal(msg, &kline)
+		if err != nil {
+			socket.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		publicOnMessage(kline)
+	})
+
+	newSocket.Handler = socket
+	return &newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Quantities are expressed in contracts
+func (delivery_ws *Delivery_Websockets) BookTicker(publicOnMessage func(bookTicker *FuturesWS_BookTicker), symbol ...string) (*FuturesWS_BookTicker_Socket, *Error) {
+	var newSocket FuturesWS_BookTicker_Socket
+
+	symbol = newSocket.CreateStreamName(symbol...)
+	socket, err := delivery_ws.CreateSocket(symbol, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		var bookTicker FuturesWS_BookTicker
+		err := json.Unmarshal(msg, &bookTicker)
+		if err != nil {
+			socket.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		publicOnMessage(&bookTicker)
+	})
+
+	newSocket.Handler = socket
+	return &newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// # User Data Streams
+//
+// Opens the COIN-M account's user data stream.
+//
+// Events are decoded into the same types as the USDⓈ-M user data stream, quantities are expressed in contracts.
+//
+// Calling 'Close()' on the returned socket also closes the listenKey.
+func (delivery_ws *Delivery_Websockets) UserData(handlers *FuturesWS_UserData_Handlers) (*FuturesWS_UserData_Socket, *Error) {
+	return createUserDataSocket(&delivery_ws.binance.Delivery, DELIVERY_Constants.Websocket.URLs[0], handlers)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (*Delivery_Websockets) CreateSocket(streams []string, isCombined bool) (*Futures_Websocket, *Error) {
+	return createFuturesSocket(DELIVERY_Constants.Websocket.URLs[0], streams, isCombined)
+}
@@ -38,6 +38,8 @@ const (
 	REQUEST_TIMEOUT_ERR
 	DATA_NOT_FOUND_ERR
 	INVALID_VALUE_ERR
+	CREDENTIALS_ERR
+	PARAMS_ENCODING_ERR
 )
 
 func newError(isLocal bool, statusCode int, code int, message string) *Error {
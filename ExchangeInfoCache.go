@@ -0,0 +1,335 @@
+package Binance
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// # Exchange info cache
+//
+// Loads a market's exchange info once with 'Start()', then refreshes it every "RefreshInterval_ms" until 'Stop()' is called.
+//
+// Lookups read an immutable snapshot, they never lock and are safe to call from any goroutine, the returned symbols must not be modified.
+//
+// Changes found by a refresh are emitted on the events below, after the new snapshot is in place, the first load emits none.
+//
+// Status changes can only be noticed as fast as the refresh interval, lower it if your bots must react quickly to a "BREAK".
+type ExchangeInfoCache[Info any, Symbol any] struct {
+	OnListed         *Event[*ExchangeInfoCache_SymbolEvent[Symbol]]
+	OnDelisted       *Event[*ExchangeInfoCache_SymbolEvent[Symbol]]
+	OnStatusChanged  *Event[*ExchangeInfoCache_SymbolEvent[Symbol]]
+	OnFiltersChanged *Event[*ExchangeInfoCache_SymbolEvent[Symbol]]
+	// The previous snapshot is kept when a refresh fails
+	OnRefreshError *Event[*Error]
+
+	snapshot atomic.Pointer[exchangeInfoSnapshot[Info, Symbol]]
+
+	fetch         func() (*Info, *Response, *Error)
+	symbols       func(info *Info) []*Symbol
+	name          func(symbol *Symbol) string
+	status        func(symbol *Symbol) string
+	filters       func(symbol *Symbol) any
+	tradingStatus string
+
+	refreshInterval time.Duration
+	refreshMu       sync.Mutex
+
+	mu   sync.Mutex
+	stop chan struct{}
+}
+
+type exchangeInfoSnapshot[Info any, Symbol any] struct {
+	info       *Info
+	symbols    map[string]*Symbol
+	updateTime int64
+}
+
+type ExchangeInfoCache_SymbolEvent[Symbol any] struct {
+	Name string
+
+	// nil for a listing
+	Previous *Symbol
+	// nil for a delisting
+	Current *Symbol
+
+	PreviousStatus string
+	Status         string
+}
+
+type ExchangeInfoCache_Params struct {
+	// Default 1 minute
+	RefreshInterval_ms int64
+}
+
+func newExchangeInfoCache[Info any, Symbol any](
+	fetch func() (*Info, *Response, *Error),
+	symbols func(info *Info) []*Symbol,
+	name func(symbol *Symbol) string,
+	status func(symbol *Symbol) string,
+	filters func(symbol *Symbol) any,
+	tradingStatus string,
+	opt_params []ExchangeInfoCache_Params,
+) *ExchangeInfoCache[Info, Symbol] {
+	cache := &ExchangeInfoCache[Info, Symbol]{
+		OnListed:         New[*ExchangeInfoCache_SymbolEvent[Symbol]](),
+		OnDelisted:       New[*ExchangeInfoCache_SymbolEvent[Symbol]](),
+		OnStatusChanged:  New[*ExchangeInfoCache_SymbolEvent[Symbol]](),
+		OnFiltersChanged: New[*ExchangeInfoCache_SymbolEvent[Symbol]](),
+		OnRefreshError:   New[*Error](),
+
+		fetch:         fetch,
+		symbols:       symbols,
+		name:          name,
+		status:        status,
+		filters:       filters,
+		tradingStatus: tradingStatus,
+
+		refreshInterval: MINUTE * time.Millisecond,
+	}
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.RefreshInterval_ms) {
+			cache.refreshInterval = time.Duration(params.RefreshInterval_ms) * time.Millisecond
+		}
+	}
+
+	return cache
+}
+
+// # Loads the exchange info and starts the scheduled refreshes
+//
+// Returns the error of the first load, in which case no refresh is scheduled.
+//
+// Does nothing if the cache is already started.
+func (cache *ExchangeInfoCache[Info, Symbol]) Start() *Error {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.stop != nil {
+		return nil
+	}
+
+	err := cache.Refresh()
+	if err != nil {
+		return err
+	}
+
+	stop := make(chan struct{})
+	cache.stop = stop
+
+	go func() {
+		ticker := time.NewTicker(cache.refreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				cache.Refresh()
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stops the scheduled refreshes, the last snapshot stays available
+func (cache *ExchangeInfoCache[Info, Symbol]) Stop() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.stop == nil {
+		return
+	}
+
+	close(cache.stop)
+	cache.stop = nil
+}
+
+// # Fetches the exchange info now and emits the changes
+//
+// Also called by the scheduled refreshes, concurrent calls are serialized.
+func (cache *ExchangeInfoCache[Info, Symbol]) Refresh() *Error {
+	cache.refreshMu.Lock()
+	defer cache.refreshMu.Unlock()
+
+	info, _, err := cache.fetch()
+	if err != nil {
+		cache.OnRefreshError.Emit(err)
+		return err
+	}
+
+	next := &exchangeInfoSnapshot[Info, Symbol]{
+		info:       info,
+		symbols:    make(map[string]*Symbol),
+		updateTime: time.Now().UnixMilli(),
+	}
+	for _, symbol := range cache.symbols(info) {
+		next.symbols[cache.name(symbol)] = symbol
+	}
+
+	previous := cache.snapshot.Swap(next)
+	if previous == nil {
+		return nil
+	}
+
+	for name, current := range next.symbols {
+		old, exists := previous.symbols[name]
+		if !exists {
+			cache.OnListed.Emit(&ExchangeInfoCache_SymbolEvent[Symbol]{
+				Name:    name,
+				Current: current,
+				Status:  cache.status(current),
+			})
+			continue
+		}
+
+		event := &ExchangeInfoCache_SymbolEvent[Symbol]{
+			Name:           name,
+			Previous:       old,
+			Current:        current,
+			PreviousStatus: cache.status(old),
+			Status:         cache.status(current),
+		}
+		if event.PreviousStatus != event.Status {
+			cache.OnStatusChanged.Emit(event)
+		}
+		if !reflect.DeepEqual(cache.filters(old), cache.filters(current)) {
+			cache.OnFiltersChanged.Emit(event)
+		}
+	}
+
+	for name, old := range previous.symbols {
+		if _, exists := next.symbols[name]; !exists {
+			cache.OnDelisted.Emit(&ExchangeInfoCache_SymbolEvent[Symbol]{
+				Name:           name,
+				Previous:       old,
+				PreviousStatus: cache.status(old),
+			})
+		}
+	}
+
+	return nil
+}
+
+// Returns nil if the cache was never loaded
+func (cache *ExchangeInfoCache[Info, Symbol]) Info() *Info {
+	snapshot := cache.snapshot.Load()
+	if snapshot == nil {
+		return nil
+	}
+	return snapshot.info
+}
+
+// Returns the time of the last successful refresh in ms, 0 if the cache was never loaded
+func (cache *ExchangeInfoCache[Info, Symbol]) UpdateTime() int64 {
+	snapshot := cache.snapshot.Load()
+	if snapshot == nil {
+		return 0
+	}
+	return snapshot.updateTime
+}
+
+func (cache *ExchangeInfoCache[Info, Symbol]) Symbol(name string) (symbol *Symbol, exists bool) {
+	snapshot := cache.snapshot.Load()
+	if snapshot == nil {
+		return nil, false
+	}
+
+	symbol, exists = snapshot.symbols[name]
+	return symbol, exists
+}
+
+// The returned map must not be modified
+func (cache *ExchangeInfoCache[Info, Symbol]) Symbols() map[string]*Symbol {
+	snapshot := cache.snapshot.Load()
+	if snapshot == nil {
+		return nil
+	}
+	return snapshot.symbols
+}
+
+// Returns false if the symbol is not listed or not trading, as of the last refresh
+func (cache *ExchangeInfoCache[Info, Symbol]) IsTrading(name string) bool {
+	symbol, exists := cache.Symbol(name)
+	if !exists {
+		return false
+	}
+	return cache.status(symbol) == cache.tradingStatus
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Spot_ExchangeInfoCache = ExchangeInfoCache[Spot_ExchangeInfo, Spot_Symbol]
+
+// # Creates a managed exchange info cache, see ExchangeInfoCache
+//
+// Call 'Start()' to load it.
+func (spot *Spot) NewExchangeInfoCache(opt_params ...ExchangeInfoCache_Params) *Spot_ExchangeInfoCache {
+	return newExchangeInfoCache(
+		spot.ExchangeInfo,
+		func(info *Spot_ExchangeInfo) []*Spot_Symbol { return info.Symbols_arr },
+		func(symbol *Spot_Symbol) string { return symbol.Symbol },
+		func(symbol *Spot_Symbol) string { return symbol.Status },
+		func(symbol *Spot_Symbol) any { return symbol.Filters },
+		SPOT_Constants.SymbolStatuses.TRADING,
+		opt_params,
+	)
+}
+
+type Futures_ExchangeInfoCache = ExchangeInfoCache[Futures_ExchangeInfo, Futures_Symbol]
+
+// # Creates a managed exchange info cache, see ExchangeInfoCache
+//
+// Call 'Start()' to load it.
+func (futures *Futures) NewExchangeInfoCache(opt_params ...ExchangeInfoCache_Params) *Futures_ExchangeInfoCache {
+	return newExchangeInfoCache(
+		futures.ExchangeInfo,
+		func(info *Futures_ExchangeInfo) []*Futures_Symbol { return info.Symbols_arr },
+		func(symbol *Futures_Symbol) string { return symbol.Symbol },
+		func(symbol *Futures_Symbol) string { return symbol.Status },
+		func(symbol *Futures_Symbol) any { return symbol.Filters },
+		FUTURES_Constants.ContractStatuses.TRADING,
+		opt_params,
+	)
+}
+
+type Delivery_ExchangeInfoCache = ExchangeInfoCache[Delivery_ExchangeInfo, Delivery_Symbol]
+
+// # Creates a managed exchange info cache, see ExchangeInfoCache
+//
+// Call 'Start()' to load it.
+func (delivery *Delivery) NewExchangeInfoCache(opt_params ...ExchangeInfoCache_Params) *Delivery_ExchangeInfoCache {
+	return newExchangeInfoCache(
+		delivery.ExchangeInfo,
+		func(info *Delivery_ExchangeInfo) []*Delivery_Symbol { return info.Symbols_arr },
+		func(symbol *Delivery_Symbol) string { return symbol.Symbol },
+		func(symbol *Delivery_Symbol) string { return symbol.ContractStatus },
+		func(symbol *Delivery_Symbol) any { return symbol.Filters },
+		FUTURES_Constants.ContractStatuses.TRADING,
+		opt_params,
+	)
+}
+
+type Options_ExchangeInfoCache = ExchangeInfoCache[Options_ExchangeInfo, Options_Symbol]
+
+// # Creates a managed exchange info cache, see ExchangeInfoCache
+//
+// Option symbols have no status, every listed symbol is considered trading.
+//
+// Call 'Start()' to load it.
+func (options *Options) NewExchangeInfoCache(opt_params ...ExchangeInfoCache_Params) *Options_ExchangeInfoCache {
+	return newExchangeInfoCache(
+		options.ExchangeInfo,
+		func(info *Options_ExchangeInfo) []*Options_Symbol { return info.Symbols_arr },
+		func(symbol *Options_Symbol) string { return symbol.Symbol },
+		func(symbol *Options_Symbol) string { return "" },
+		func(symbol *Options_Symbol) any { return symbol.Filters },
+		"",
+		opt_params,
+	)
+}
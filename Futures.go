@@ -147,7 +147,15 @@ func (symbol *Futures_Symbol) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	for _, filter := range aux.Filters {
+	symbol.Filters.parse(symbol.Symbol, aux.Filters)
+
+	return nil
+}
+
+// Shared between the USDⓈ-M and COIN-M symbols, their filters are identical
+func (filters *Futures_SymbolFilters) parse(symbolName string, rawFilters []jsoniter.RawMessage) {
+	var err error
+	for _, filter := range rawFilters {
 		var tempObj map[string]interface{}
 		if err := json.Unmarshal(filter, &tempObj); err != nil {
 			LOG_ERRORS("Error unmarshalling into temp map:", err)
@@ -156,42 +164,129 @@ func (symbol *Futures_Symbol) UnmarshalJSON(data []byte) error {
 
 		switch tempObj["filterType"] {
 		case FUTURES_Constants.SymbolFilterTypes.PRICE_FILTER:
-			symbol.Filters.PRICE_FILTER = &Futures_SymbolFilter_PRICE_FILTER{}
-			err = json.Unmarshal(filter, &symbol.Filters.PRICE_FILTER)
+			filters.PRICE_FILTER = &Futures_SymbolFilter_PRICE_FILTER{}
+			err = json.Unmarshal(filter, &filters.PRICE_FILTER)
 
 		case FUTURES_Constants.SymbolFilterTypes.LOT_SIZE:
-			symbol.Filters.LOT_SIZE = &Futures_SymbolFilter_LOT_SIZE{}
-			err = json.Unmarshal(filter, &symbol.Filters.LOT_SIZE)
+			filters.LOT_SIZE = &Futures_SymbolFilter_LOT_SIZE{}
+			err = json.Unmarshal(filter, &filters.LOT_SIZE)
 
 		case FUTURES_Constants.SymbolFilterTypes.MARKET_LOT_SIZE:
-			symbol.Filters.MARKET_LOT_SIZE = &Futures_SymbolFilter_MARKET_LOT_SIZE{}
-			err = json.Unmarshal(filter, &symbol.Filters.MARKET_LOT_SIZE)
+			filters.MARKET_LOT_SIZE = &Futures_SymbolFilter_MARKET_LOT_SIZE{}
+			err = json.Unmarshal(filter, &filters.MARKET_LOT_SIZE)
 
 		case FUTURES_Constants.SymbolFilterTypes.MAX_NUM_ORDERS:
-			symbol.Filters.MAX_NUM_ORDERS = &Futures_SymbolFilter_MAX_NUM_ORDERS{}
-			err = json.Unmarshal(filter, &symbol.Filters.MAX_NUM_ORDERS)
+			filters.MAX_NUM_ORDERS = &Futures_SymbolFilter_MAX_NUM_ORDERS{}
+			err = json.Unmarshal(filter, &filters.MAX_NUM_ORDERS)
 
 		case FUTURES_Constants.SymbolFilterTypes.MAX_NUM_ALGO_ORDERS:
-			symbol.Filters.MAX_NUM_ALGO_ORDERS = &Futures_SymbolFilter_MAX_NUM_ALGO_ORDERS{}
-			err = json.Unmarshal(filter, &symbol.Filters.MAX_NUM_ALGO_ORDERS)
+			filters.MAX_NUM_ALGO_ORDERS = &Futures_SymbolFilter_MAX_NUM_ALGO_ORDERS{}
+			err = json.Unmarshal(filter, &filters.MAX_NUM_ALGO_ORDERS)
 
 		case FUTURES_Constants.SymbolFilterTypes.PERCENT_PRICE:
-			symbol.Filters.PERCENT_PRICE = &Futures_SymbolFilter_PERCENT_PRICE{}
-			err = json.Unmarshal(filter, &symbol.Filters.PERCENT_PRICE)
+			filters.PERCENT_PRICE = &Futures_SymbolFilter_PERCENT_PRICE{}
+			err = json.Unmarshal(filter, &filters.PERCENT_PRICE)
 
 		case FUTURES_Constants.SymbolFilterTypes.MIN_NOTIONAL:
-			symbol.Filters.MIN_NOTIONAL = &Futures_SymbolFilter_MIN_NOTIONAL{}
-			err = json.Unmarshal(filter, &symbol.Filters.MIN_NOTIONAL)
+			filters.MIN_NOTIONAL = &Futures_SymbolFilter_MIN_NOTIONAL{}
+			err = json.Unmarshal(filter, &filters.MIN_NOTIONAL)
 		default:
-			LOG_ERRORS("A missing field was intercepted of value", tempObj["filterType"], "in the", symbol.Symbol, "symbol's info.")
+			LOG_ERRORS("A missing field was intercepted of value", tempObj["filterType"], "in the", symbolName, "symbol's info.")
 		}
 		if err != nil {
-			LOG_ERRORS("There was an error parsing", tempObj["filterType"], "in the", symbol.Symbol, "symbol's info =>", err)
+			LOG_ERRORS("There was an error parsing", tempObj["filterType"], "in the", symbolName, "symbol's info =>", err)
 		}
 
 	}
+}
 
-	return nil
+/////////////////////////////////////////////////////////////////////////////////
+
+// Empty fields are not filtered on
+type Futures_SymbolQuery struct {
+	BaseAsset   string
+	QuoteAsset  string
+	MarginAsset string
+	Pair        string
+	// One of FUTURES_Constants.ContractStatuses
+	Status string
+	// One of FUTURES_Constants.ContractTypes
+	ContractType string
+	// i.e: "COIN", "INDEX", "PREMARKET"
+	UnderlyingType string
+	// Every sub type must be in the symbol's "underlyingSubType"
+	UnderlyingSubTypes []string
+	// Every order type must be supported by the symbol
+	OrderTypes []string
+}
+
+// # Returns the symbols matching every field of the query, in the exchange info's order
+func (exchangeInfo *Futures_ExchangeInfo) QuerySymbols(query Futures_SymbolQuery) []*Futures_Symbol {
+	symbols := make([]*Futures_Symbol, 0)
+
+	for _, symbol := range exchangeInfo.Symbols_arr {
+		if query.BaseAsset != "" && symbol.BaseAsset != query.BaseAsset {
+			continue
+		}
+		if query.QuoteAsset != "" && symbol.QuoteAsset != query.QuoteAsset {
+			continue
+		}
+		if query.MarginAsset != "" && symbol.MarginAsset != query.MarginAsset {
+			continue
+		}
+		if query.Pair != "" && symbol.Pair != query.Pair {
+			continue
+		}
+		if query.Status != "" && symbol.Status != query.Status {
+			continue
+		}
+		if query.ContractType != "" && symbol.ContractType != query.ContractType {
+			continue
+		}
+		if query.UnderlyingType != "" && symbol.UnderlyingType != query.UnderlyingType {
+			continue
+		}
+		if !containsAll(symbol.UnderlyingSubType, query.UnderlyingSubTypes) {
+			continue
+		}
+		if !containsAll(symbol.OrderType, query.OrderTypes) {
+			continue
+		}
+
+		symbols = append(symbols, symbol)
+	}
+
+	return symbols
+}
+
+// Returns the quote assets, sorted and without duplicates
+//
+// "status" (one of FUTURES_Constants.ContractStatuses) only counts the symbols with that status, all symbols are counted if omitted
+func (exchangeInfo *Futures_ExchangeInfo) QuoteAssets(status ...string) []string {
+	assets := make([]string, 0)
+	for _, symbol := range exchangeInfo.Symbols_arr {
+		if len(status) != 0 && symbol.Status != status[0] {
+			continue
+		}
+		assets = append(assets, symbol.QuoteAsset)
+	}
+
+	return uniqueSorted(assets)
+}
+
+// Returns the margin assets, sorted and without duplicates
+//
+// "status" (one of FUTURES_Constants.ContractStatuses) only counts the symbols with that status, all symbols are counted if omitted
+func (exchangeInfo *Futures_ExchangeInfo) MarginAssets(status ...string) []string {
+	assets := make([]string, 0)
+	for _, symbol := range exchangeInfo.Symbols_arr {
+		if len(status) != 0 && symbol.Status != status[0] {
+			continue
+		}
+		assets = append(assets, symbol.MarginAsset)
+	}
+
+	return uniqueSorted(assets)
 }
 
 //////// ExchangeInfo //
@@ -320,7 +415,7 @@ type Futures_AggTrade_Params struct {
 	Limit int64
 }
 
-func (futures *Futures) AggTrades(symbol string, opt_params ...Futures_AggTrade_Params) ([]*Futures_AggTrade_Params, *Response, *Error) {
+func (futures *Futures) AggTrades(symbol string, opt_params ...Futures_AggTrade_Params) ([]*Futures_AggTrade, *Response, *Error) {
 	opts := make(map[string]interface{})
 
 	opts["symbol"] = symbol
@@ -354,7 +449,7 @@ func (futures *Futures) AggTrades(symbol string, opt_params ...Futures_AggTrade_
 		return nil, resp, err
 	}
 
-	aggTrades := make([]*Futures_AggTrade_Params, limit)
+	aggTrades := make([]*Futures_AggTrade, limit)
 
 	unmarshallErr := json.Unmarshal(resp.Body, &aggTrades)
 	if unmarshallErr != nil {
@@ -411,20 +506,24 @@ func (futures *Futures) Candlesticks(symbol string, interval string, opt_params
 	// Convert the raw data to Futures_Candlestick slice
 	candlesticks := make([]*Futures_Candlestick, len(rawCandlesticks))
 	for i, raw := range rawCandlesticks {
+		var parser decimalParser
 		candlesticks[i] = &Futures_Candlestick{
 			OpenTime:                 int64(raw[0].(float64)),
-			Open:                     raw[1].(string),
-			High:                     raw[2].(string),
-			Low:                      raw[3].(string),
-			Close:                    raw[4].(string),
-			Volume:                   raw[5].(string),
+			Open:                     parser.kline(raw, 1),
+			High:                     parser.kline(raw, 2),
+			Low:                      parser.kline(raw, 3),
+			Close:                    parser.kline(raw, 4),
+			Volume:                   parser.kline(raw, 5),
 			CloseTime:                int64(raw[6].(float64)),
-			QuoteAssetVolume:         raw[7].(string),
+			QuoteAssetVolume:         parser.kline(raw, 7),
 			TradeCount:               int64(raw[8].(float64)),
-			TakerBuyBaseAssetVolume:  raw[9].(string),
-			TakerBuyQuoteAssetVolume: raw[10].(string),
+			TakerBuyBaseAssetVolume:  parser.kline(raw, 9),
+			TakerBuyQuoteAssetVolume: parser.kline(raw, 10),
 			Unused:                   raw[11].(string),
 		}
+		if parser.err != nil {
+			return nil, resp, LocalError(PARSING_ERR, parser.err.Error())
+		}
 	}
 
 	return candlesticks, resp, nil
@@ -477,20 +576,24 @@ func (futures *Futures) ContinuousContractCandlesticks(symbol string, contractTy
 	// Convert the raw data to Futures_Candlestick slice
 	candlesticks := make([]*Futures_Candlestick, len(rawCandlesticks))
 	for i, raw := range rawCandlesticks {
+		var parser decimalParser
 		candlesticks[i] = &Futures_Candlestick{
 			OpenTime:                 int64(raw[0].(float64)),
-			Open:                     raw[1].(string),
-			High:                     raw[2].(string),
-			Low:                      raw[3].(string),
-			Close:                    raw[4].(string),
-			Volume:                   raw[5].(string),
+			Open:                     parser.kline(raw, 1),
+			High:                     parser.kline(raw, 2),
+			Low:                      parser.kline(raw, 3),
+			Close:                    parser.kline(raw, 4),
+			Volume:                   parser.kline(raw, 5),
 			CloseTime:                int64(raw[6].(float64)),
-			QuoteAssetVolume:         raw[7].(string),
+			QuoteAssetVolume:         parser.kline(raw, 7),
 			TradeCount:               int64(raw[8].(float64)),
-			TakerBuyBaseAssetVolume:  raw[9].(string),
-			TakerBuyQuoteAssetVolume: raw[10].(string),
+			TakerBuyBaseAssetVolume:  parser.kline(raw, 9),
+			TakerBuyQuoteAssetVolume: parser.kline(raw, 10),
 			Unused:                   raw[11].(string),
 		}
+		if parser.err != nil {
+			return nil, resp, LocalError(PARSING_ERR, parser.err.Error())
+		}
 	}
 
 	return candlesticks, resp, nil
@@ -543,12 +646,13 @@ func (futures *Futures) IndexPriceCandlesticks(symbol string, interval string, o
 	// Convert the raw data to Futures_Candlestick slice
 	candlesticks := make([]*Futures_PriceCandlestick, len(rawCandlesticks))
 	for i, raw := range rawCandlesticks {
+		var parser decimalParser
 		candlesticks[i] = &Futures_PriceCandlestick{
 			OpenTime:  int64(raw[0].(float64)),
-			Open:      raw[1].(string),
-			High:      raw[2].(string),
-			Low:       raw[3].(string),
-			Close:     raw[4].(string),
+			Open:      parser.kline(raw, 1),
+			High:      parser.kline(raw, 2),
+			Low:       parser.kline(raw, 3),
+			Close:     parser.kline(raw, 4),
 			Ignore1:   raw[5].(string),
 			CloseTime: int64(raw[6].(float64)),
 			Ignore2:   raw[7].(string),
@@ -557,6 +661,9 @@ func (futures *Futures) IndexPriceCandlesticks(symbol string, interval string, o
 			Ignore5:   raw[10].(string),
 			Unused:    raw[11].(string),
 		}
+		if parser.err != nil {
+			return nil, resp, LocalError(PARSING_ERR, parser.err.Error())
+		}
 	}
 
 	return candlesticks, resp, nil
@@ -602,12 +709,13 @@ func (futures *Futures) MarkPriceCandlesticks(symbol string, contractType string
 	// Convert the raw data to Futures_Candlestick slice
 	candlesticks := make([]*Futures_PriceCandlestick, len(rawCandlesticks))
 	for i, raw := range rawCandlesticks {
+		var parser decimalParser
 		candlesticks[i] = &Futures_PriceCandlestick{
 			OpenTime:  int64(raw[0].(float64)),
-			Open:      raw[1].(string),
-			High:      raw[2].(string),
-			Low:       raw[3].(string),
-			Close:     raw[4].(string),
+			Open:      parser.kline(raw, 1),
+			High:      parser.kline(raw, 2),
+			Low:       parser.kline(raw, 3),
+			Close:     parser.kline(raw, 4),
 			Ignore1:   raw[5].(string),
 			CloseTime: int64(raw[6].(float64)),
 			Ignore2:   raw[7].(string),
@@ -616,6 +724,9 @@ func (futures *Futures) MarkPriceCandlesticks(symbol string, contractType string
 			Ignore5:   raw[10].(string),
 			Unused:    raw[11].(string),
 		}
+		if parser.err != nil {
+			return nil, resp, LocalError(PARSING_ERR, parser.err.Error())
+		}
 	}
 
 	return candlesticks, resp, nil
@@ -661,12 +772,13 @@ func (futures *Futures) PremiumIndexCandlesticks(symbol string, contractType str
 	// Convert the raw data to Futures_Candlestick slice
 	candlesticks := make([]*Futures_PriceCandlestick, len(rawCandlesticks))
 	for i, raw := range rawCandlesticks {
+		var parser decimalParser
 		candlesticks[i] = &Futures_PriceCandlestick{
 			OpenTime:  int64(raw[0].(float64)),
-			Open:      raw[1].(string),
-			High:      raw[2].(string),
-			Low:       raw[3].(string),
-			Close:     raw[4].(string),
+			Open:      parser.kline(raw, 1),
+			High:      parser.kline(raw, 2),
+			Low:       parser.kline(raw, 3),
+			Close:     parser.kline(raw, 4),
 			Ignore1:   raw[5].(string),
 			CloseTime: int64(raw[6].(float64)),
 			Ignore2:   raw[7].(string),
@@ -675,6 +787,9 @@ func (futures *Futures) PremiumIndexCandlesticks(symbol string, contractType str
 			Ignore5:   raw[10].(string),
 			Unused:    raw[11].(string),
 		}
+		if parser.err != nil {
+			return nil, resp, LocalError(PARSING_ERR, parser.err.Error())
+		}
 	}
 
 	return candlesticks, resp, nil
@@ -768,6 +883,7 @@ func (futures *Futures) FundingRateHistory(opt_params ...Futures_FundingRate_Par
 	return fundingRates, resp, nil
 }
 
+// Deprecated: '/fapi/v1/fundingInfo' doesn't return funding rates, use FundingInfo() instead
 func (futures *Futures) FundingRate() ([]*Futures_FundingRate, *Response, *Error) {
 	resp, err := futures.makeRequest(&FuturesRequest{
 		securityType: FUTURES_Constants.SecurityTypes.NONE,
@@ -788,6 +904,31 @@ func (futures *Futures) FundingRate() ([]*Futures_FundingRate, *Response, *Error
 	return fundingRates, resp, nil
 }
 
+// # Get Funding Rate Info
+//
+// Query funding rate info for symbols that had FundingRateCap/FundingRateFloor/fundingIntervalHours adjustment
+//
+// Weight: 0 (shares 500/5min/IP rate limit with FundingRateHistory)
+func (futures *Futures) FundingInfo() ([]*Futures_FundingInfo, *Response, *Error) {
+	resp, err := futures.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/fapi/v1/fundingInfo",
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var fundingInfos []*Futures_FundingInfo
+
+	unmarshallErr := json.Unmarshal(resp.Body, &fundingInfos)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return fundingInfos, resp, nil
+}
+
 /////////////////////////////////////////////////////////////////////////////////
 
 // If the symbol is not sent, bookTickers for all symbols will be returned in an array.
@@ -1036,6 +1177,315 @@ func (futures *Futures) OpenInterestStatistics(symbol string, period string, opt
 	return openInterestStatistics, resp, nil
 }
 
+/////////////////////////////////////////////////////////////////////////////////
+
+type Futures_LongShortRatio_Params struct {
+	Limit     int64
+	StartTime int64
+	EndTime   int64
+}
+
+// # Top Trader Long/Short Ratio (Accounts)
+//
+// The proportion of net long and net short accounts to total accounts of the top 20% users with the highest margin balance.
+//
+// "period" can be any of FUTURES_Constants.StatisticsPeriods
+//
+// If startTime and endTime are not sent, the most recent data is returned, only the data of the latest 30 days is available.
+//
+// Weight: 0
+func (futures *Futures) TopTraderLongShortAccountRatio(symbol string, period string, opt_params ...Futures_LongShortRatio_Params) ([]*Futures_LongShortRatio, *Response, *Error) {
+	return futures.longShortRatio("/futures/data/topLongShortAccountRatio", symbol, period, opt_params...)
+}
+
+// # Top Trader Long/Short Ratio (Positions)
+//
+// The proportion of net long and net short positions to total open positions of the top 20% users with the highest margin balance.
+//
+// "period" can be any of FUTURES_Constants.StatisticsPeriods
+//
+// If startTime and endTime are not sent, the most recent data is returned, only the data of the latest 30 days is available.
+//
+// Weight: 0
+func (futures *Futures) TopTraderLongShortPositionRatio(symbol string, period string, opt_params ...Futures_LongShortRatio_Params) ([]*Futures_LongShortRatio, *Response, *Error) {
+	return futures.longShortRatio("/futures/data/topLongShortPositionRatio", symbol, period, opt_params...)
+}
+
+// # Long/Short Ratio
+//
+// The long/short ratio of all accounts holding a position on the symbol.
+//
+// "period" can be any of FUTURES_Constants.StatisticsPeriods
+//
+// If startTime and endTime are not sent, the most recent data is returned, only the data of the latest 30 days is available.
+//
+// Weight: 0
+func (futures *Futures) GlobalLongShortAccountRatio(symbol string, period string, opt_params ...Futures_LongShortRatio_Params) ([]*Futures_LongShortRatio, *Response, *Error) {
+	return futures.longShortRatio("/futures/data/globalLongShortAccountRatio", symbol, period, opt_params...)
+}
+
+func (futures *Futures) longShortRatio(url string, symbol string, period string, opt_params ...Futures_LongShortRatio_Params) ([]*Futures_LongShortRatio, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["symbol"] = symbol
+	opts["period"] = period
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+	}
+
+	resp, err := futures.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          url,
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var longShortRatios []*Futures_LongShortRatio
+	unmarshallErr := json.Unmarshal(resp.Body, &longShortRatios)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return longShortRatios, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Futures_TakerBuySellVolume_Params struct {
+	Limit     int64
+	StartTime int64
+	EndTime   int64
+}
+
+// # Taker Buy/Sell Volume
+//
+// "period" can be any of FUTURES_Constants.StatisticsPeriods
+//
+// If startTime and endTime are not sent, the most recent data is returned, only the data of the latest 30 days is available.
+//
+// Weight: 0
+func (futures *Futures) TakerBuySellVolume(symbol string, period string, opt_params ...Futures_TakerBuySellVolume_Params) ([]*Futures_TakerBuySellVolume, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["symbol"] = symbol
+	opts["period"] = period
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+	}
+
+	resp, err := futures.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/futures/data/takerlongshortRatio",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var takerBuySellVolumes []*Futures_TakerBuySellVolume
+	unmarshallErr := json.Unmarshal(resp.Body, &takerBuySellVolumes)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return takerBuySellVolumes, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Futures_Basis_Params struct {
+	Limit     int64
+	StartTime int64
+	EndTime   int64
+}
+
+// # Basis
+//
+// "contractType" can be any of FUTURES_Constants.ContractTypes
+//
+// "period" can be any of FUTURES_Constants.StatisticsPeriods
+//
+// If startTime and endTime are not sent, the most recent data is returned, only the data of the latest 30 days is available.
+//
+// Weight: 0
+func (futures *Futures) Basis(pair string, contractType string, period string, opt_params ...Futures_Basis_Params) ([]*Futures_Basis, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["pair"] = pair
+	opts["contractType"] = contractType
+	opts["period"] = period
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+	}
+
+	resp, err := futures.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/futures/data/basis",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var basis []*Futures_Basis
+	unmarshallErr := json.Unmarshal(resp.Body, &basis)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return basis, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Index Price Constituents
+//
+// Weight: 2
+func (futures *Futures) IndexConstituents(symbol string) (*Futures_IndexConstituents, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["symbol"] = symbol
+
+	resp, err := futures.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/fapi/v1/constituents",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var indexConstituents *Futures_IndexConstituents
+	unmarshallErr := json.Unmarshal(resp.Body, &indexConstituents)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return indexConstituents, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Insurance Fund Balance Snapshot
+//
+// If the symbol is not sent, the balances of all insurance funds will be returned in an array.
+//
+// Weight: 1
+func (futures *Futures) InsuranceBalance(symbol ...string) ([]*Futures_InsuranceBalance, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(symbol) != 0 {
+		opts["symbol"] = symbol[0]
+	}
+
+	resp, err := futures.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/fapi/v1/insuranceBalance",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(symbol) != 0 {
+		var insuranceBalance Futures_InsuranceBalance
+
+		unmarshallErr := json.Unmarshal(resp.Body, &insuranceBalance)
+		if unmarshallErr != nil {
+			return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+		}
+
+		return []*Futures_InsuranceBalance{&insuranceBalance}, resp, nil
+	} else {
+		var insuranceBalances []*Futures_InsuranceBalance
+
+		unmarshallErr := json.Unmarshal(resp.Body, &insuranceBalances)
+		if unmarshallErr != nil {
+			return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+		}
+
+		return insuranceBalances, resp, nil
+	}
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Multi-Assets Mode Asset Index
+//
+// If the symbol is not sent, the asset indexes of all symbols will be returned in an array.
+//
+// Weight: 1 for a single symbol, 10 when the symbol parameter is omitted
+func (futures *Futures) AssetIndex(symbol ...string) ([]*Futures_AssetIndex, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(symbol) != 0 {
+		opts["symbol"] = symbol[0]
+	}
+
+	resp, err := futures.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/fapi/v1/assetIndex",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(symbol) != 0 {
+		var assetIndex Futures_AssetIndex
+
+		unmarshallErr := json.Unmarshal(resp.Body, &assetIndex)
+		if unmarshallErr != nil {
+			return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+		}
+
+		return []*Futures_AssetIndex{&assetIndex}, resp, nil
+	} else {
+		var assetIndexes []*Futures_AssetIndex
+
+		unmarshallErr := json.Unmarshal(resp.Body, &assetIndexes)
+		if unmarshallErr != nil {
+			return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+		}
+
+		return assetIndexes, resp, nil
+	}
+}
+
 // //////////////////////////// Orders \\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\
 
 // # DISCLAIMER
@@ -1499,6 +1949,73 @@ func (futures *Futures) LeverageBrackets(symbol ...string) ([]*Futures_LeverageB
 /////////////////////////////////////////////////////////////////////////////////
 /////////////////////////////////////////////////////////////////////////////////
 
+// # Start User Data Stream
+//
+// Start a new user data stream. The stream will close after 60 minutes unless a keepalive is sent.
+//
+// If the account has an active listenKey, that listenKey will be returned and its validity will be extended for 60 minutes.
+//
+// Weight: 1
+func (futures *Futures) CreateListenKey() (*Futures_ListenKey, *Response, *Error) {
+	resp, err := futures.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.USER_STREAM,
+		method:       Constants.Methods.POST,
+		url:          "/fapi/v1/listenKey",
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var listenKey *Futures_ListenKey
+	processingErr := json.Unmarshal(resp.Body, &listenKey)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return listenKey, resp, nil
+}
+
+// # Keepalive User Data Stream
+//
+// Keepalive a user data stream to prevent a time out. User data streams will close after 60 minutes.
+//
+// It's recommended to send a ping about every 60 minutes.
+//
+// Weight: 1
+func (futures *Futures) KeepAliveListenKey() (*Futures_ListenKey, *Response, *Error) {
+	resp, err := futures.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.USER_STREAM,
+		method:       Constants.Methods.PUT,
+		url:          "/fapi/v1/listenKey",
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var listenKey *Futures_ListenKey
+	processingErr := json.Unmarshal(resp.Body, &listenKey)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return listenKey, resp, nil
+}
+
+// # Close User Data Stream
+//
+// Close out a user data stream.
+//
+// Weight: 1
+func (futures *Futures) CloseListenKey() (*Response, *Error) {
+	return futures.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.USER_STREAM,
+		method:       Constants.Methods.DELETE,
+		url:          "/fapi/v1/listenKey",
+	})
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
 // This fetches more than the simple limit of 1500 candlesticks
 func (customMethods *futures_Custom_Methods) Batch_Candlesticks(symbol string, interval string, startTime int64, endTime int64) ([]*Futures_Candlestick, error) {
 	allCandlesticks := []*Futures_Candlestick{}
@@ -1535,10 +2052,7 @@ func (customMethods *futures_Custom_Methods) Batch_Candlesticks_float64(symbol s
 
 	parsedCandlesticks := make([]*FuturesWS_Candlestick_Float64, len(allCandlesticks))
 	for i := range allCandlesticks {
-		parsedCandlesticks[i], err = parseFloat_Futures_Candlestick(allCandlesticks[i])
-		if err != nil {
-			return nil, err
-		}
+		parsedCandlesticks[i] = parseFloat_Futures_Candlestick(allCandlesticks[i])
 	}
 
 	return parsedCandlesticks, nil
@@ -1,8 +1,6 @@
 package Binance
 
 import (
-	"fmt"
-	"math"
 	"strconv"
 	"sync"
 )
@@ -27,7 +25,8 @@ var FUTURES_Constants = struct {
 
 	NewOrderRespTypes Futures_NewOrderRespTypes_ENUM
 
-	ChartIntervals Futures_ChartIntervals_ENUM
+	ChartIntervals    Futures_ChartIntervals_ENUM
+	StatisticsPeriods Futures_StatisticsPeriods_ENUM
 
 	STPModes   Futures_STPModes_ENUM
 	PriceMatch Futures_PriceMatch_ENUM
@@ -36,6 +35,10 @@ var FUTURES_Constants = struct {
 	RateLimitTypes     Futures_RateLimitTypes_ENUM
 	RateLimitIntervals Futures_RateLimitIntervals_ENUM
 
+	UserDataEventTypes   Futures_UserDataEventTypes_ENUM
+	AccountUpdateReasons Futures_AccountUpdateReasons_ENUM
+	ExecutionTypes       Futures_ExecutionTypes_ENUM
+
 	Websocket Futures_Websocket_Constants
 }{
 	URLs: [1]string{"https://fapi.binance.com"},
@@ -124,6 +127,17 @@ var FUTURES_Constants = struct {
 		WEEK:     "1w",
 		MONTH:    "1M",
 	},
+	StatisticsPeriods: Futures_StatisticsPeriods_ENUM{
+		MINS_5:   "5m",
+		MINS_15:  "15m",
+		MINS_30:  "30m",
+		HOUR:     "1h",
+		HOURS_2:  "2h",
+		HOURS_4:  "4h",
+		HOURS_6:  "6h",
+		HOURS_12: "12h",
+		DAY:      "1d",
+	},
 	STPModes: Futures_STPModes_ENUM{
 		NONE:         "NONE",
 		EXPIRE_TAKER: "EXPIRE_TAKER",
@@ -159,8 +173,47 @@ var FUTURES_Constants = struct {
 		MINUTE: "MINUTE",
 		DAY:    "DAY",
 	},
+	UserDataEventTypes: Futures_UserDataEventTypes_ENUM{
+		LISTEN_KEY_EXPIRED:               "listenKeyExpired",
+		ACCOUNT_UPDATE:                   "ACCOUNT_UPDATE",
+		MARGIN_CALL:                      "MARGIN_CALL",
+		ORDER_TRADE_UPDATE:               "ORDER_TRADE_UPDATE",
+		TRADE_LITE:                       "TRADE_LITE",
+		ACCOUNT_CONFIG_UPDATE:            "ACCOUNT_CONFIG_UPDATE",
+		STRATEGY_UPDATE:                  "STRATEGY_UPDATE",
+		GRID_UPDATE:                      "GRID_UPDATE",
+		CONDITIONAL_ORDER_TRIGGER_REJECT: "CONDITIONAL_ORDER_TRIGGER_REJECT",
+	},
+	AccountUpdateReasons: Futures_AccountUpdateReasons_ENUM{
+		DEPOSIT:               "DEPOSIT",
+		WITHDRAW:              "WITHDRAW",
+		ORDER:                 "ORDER",
+		FUNDING_FEE:           "FUNDING_FEE",
+		WITHDRAW_REJECT:       "WITHDRAW_REJECT",
+		ADJUSTMENT:            "ADJUSTMENT",
+		INSURANCE_CLEAR:       "INSURANCE_CLEAR",
+		ADMIN_DEPOSIT:         "ADMIN_DEPOSIT",
+		ADMIN_WITHDRAW:        "ADMIN_WITHDRAW",
+		MARGIN_TRANSFER:       "MARGIN_TRANSFER",
+		MARGIN_TYPE_CHANGE:    "MARGIN_TYPE_CHANGE",
+		ASSET_TRANSFER:        "ASSET_TRANSFER",
+		OPTIONS_PREMIUM_FEE:   "OPTIONS_PREMIUM_FEE",
+		OPTIONS_SETTLE_PROFIT: "OPTIONS_SETTLE_PROFIT",
+		AUTO_EXCHANGE:         "AUTO_EXCHANGE",
+		COIN_SWAP_DEPOSIT:     "COIN_SWAP_DEPOSIT",
+		COIN_SWAP_WITHDRAW:    "COIN_SWAP_WITHDRAW",
+	},
+	ExecutionTypes: Futures_ExecutionTypes_ENUM{
+		NEW:        "NEW",
+		CANCELED:   "CANCELED",
+		CALCULATED: "CALCULATED",
+		EXPIRED:    "EXPIRED",
+		TRADE:      "TRADE",
+		AMENDMENT:  "AMENDMENT",
+	},
 	Websocket: Futures_Websocket_Constants{
-		URLs: []string{"wss://fstream.binance.com"},
+		URLs:                             []string{"wss://fstream.binance.com"},
+		LISTENKEY_KEEPALIVE_INTERVAL_SEC: (30 * MINUTE) / SECOND,
 	},
 }
 
@@ -265,6 +318,18 @@ type Futures_ChartIntervals_ENUM struct {
 	MONTH    string
 }
 
+type Futures_StatisticsPeriods_ENUM struct {
+	MINS_5   string
+	MINS_15  string
+	MINS_30  string
+	HOUR     string
+	HOURS_2  string
+	HOURS_4  string
+	HOURS_6  string
+	HOURS_12 string
+	DAY      string
+}
+
 type Futures_STPModes_ENUM struct {
 	NONE         string
 	EXPIRE_TAKER string
@@ -305,8 +370,52 @@ type Futures_RateLimitIntervals_ENUM struct {
 	DAY    string
 }
 
+type Futures_UserDataEventTypes_ENUM struct {
+	LISTEN_KEY_EXPIRED               string
+	ACCOUNT_UPDATE                   string
+	MARGIN_CALL                      string
+	ORDER_TRADE_UPDATE               string
+	TRADE_LITE                       string
+	ACCOUNT_CONFIG_UPDATE            string
+	STRATEGY_UPDATE                  string
+	GRID_UPDATE                      string
+	CONDITIONAL_ORDER_TRIGGER_REJECT string
+}
+
+type Futures_AccountUpdateReasons_ENUM struct {
+	DEPOSIT               string
+	WITHDRAW              string
+	ORDER                 string
+	FUNDING_FEE           string
+	WITHDRAW_REJECT       string
+	ADJUSTMENT            string
+	INSURANCE_CLEAR       string
+	ADMIN_DEPOSIT         string
+	ADMIN_WITHDRAW        string
+	MARGIN_TRANSFER       string
+	MARGIN_TYPE_CHANGE    string
+	ASSET_TRANSFER        string
+	OPTIONS_PREMIUM_FEE   string
+	OPTIONS_SETTLE_PROFIT string
+	AUTO_EXCHANGE         string
+	COIN_SWAP_DEPOSIT     string
+	COIN_SWAP_WITHDRAW    string
+}
+
+type Futures_ExecutionTypes_ENUM struct {
+	NEW        string
+	CANCELED   string
+	CALCULATED string
+	EXPIRED    string
+	TRADE      string
+	AMENDMENT  string
+}
+
 type Futures_Websocket_Constants struct {
 	URLs []string
+
+	// A listenKey is valid for 60 minutes, it is kept alive every 30 minutes
+	LISTENKEY_KEEPALIVE_INTERVAL_SEC int64
 }
 
 type Futures_RateLimitType struct {
@@ -341,6 +450,7 @@ type Futures_Symbol struct {
 	RequiredMarginPercent string   `json:"requiredMarginPercent"`
 	BaseAsset             string   `json:"baseAsset"`
 	QuoteAsset            string   `json:"quoteAsset"`
+	MarginAsset           string   `json:"marginAsset"`
 	PricePrecision        int64    `json:"pricePrecision"`
 	QuantityPrecision     int64    `json:"quantityPrecision"`
 	BaseAssetPrecision    int64    `json:"baseAssetPrecision"`
@@ -369,42 +479,33 @@ type Futures_Symbol struct {
 // "suggestion" must be ignored if it is returned as 0.
 // "suggestion" is always returned as "price" if it passes the filter.
 func (futuresSymbol *Futures_Symbol) PRICE_FILTER(price float64) (isValid bool, reason string, suggestion float64, err *Error) {
-
-	if futuresSymbol.Filters.PRICE_FILTER == nil {
-		return true, "", price, nil
-	}
-
-	minPrice, parseErr := strconv.ParseFloat(futuresSymbol.Filters.PRICE_FILTER.MinPrice, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	maxPrice, parseErr := strconv.ParseFloat(futuresSymbol.Filters.PRICE_FILTER.MaxPrice, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	tickSize, parseErr := strconv.ParseFloat(futuresSymbol.Filters.PRICE_FILTER.TickSize, 64)
+	exactPrice, parseErr := NewDecimalFromFloat(price)
 	if parseErr != nil {
 		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
 	}
 
-	if minPrice != 0 && price < minPrice {
-		return false, "minPrice", minPrice, nil
+	isValid, reason, exactSuggestion, err := futuresSymbol.PRICE_FILTER_Decimal(exactPrice)
+	if err != nil {
+		return false, "", 0, err
 	}
-
-	if maxPrice != 0 && price > maxPrice {
-		return false, "maxPrice", maxPrice, nil
+	if isValid {
+		return true, "", price, nil
 	}
 
-	if tickSize != 0 && math.Remainder(price, tickSize) != 0 {
-		suggestion, parseErr := strconv.ParseFloat(Format_TickSize_str(fmt.Sprint(price), futuresSymbol.Filters.PRICE_FILTER.TickSize), 64)
-		if parseErr != nil {
-			return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-		}
+	return false, reason, exactSuggestion.Float64(), nil
+}
 
-		return false, "tickSize", suggestion, nil
+// # Checks if the price passes the "PRICE_FILTER", compared exactly
+//
+// Same as PRICE_FILTER(), without the float drift of "price" % tickSize
+func (futuresSymbol *Futures_Symbol) PRICE_FILTER_Decimal(price Decimal) (isValid bool, reason string, suggestion Decimal, err *Error) {
+	filter := futuresSymbol.Filters.PRICE_FILTER
+	if filter == nil {
+		return true, "", price, nil
 	}
 
-	return true, "", price, nil
+	isValid, reason, suggestion = checkFilterRange("PRICE_FILTER", "price", price, filter.MinPrice, filter.MaxPrice, filter.TickSize, "minPrice", "maxPrice", "tickSize")
+	return isValid, reason, suggestion, nil
 }
 
 // # Checks if the price passes the "PRICE_FILTER"
@@ -426,42 +527,33 @@ func (futuresSymbol *Futures_Symbol) PRICE_FILTER_COMPACT(price float64) (isVali
 // "suggestion" must be ignored if it is returned as 0.
 // "suggestion" is always returned as "quantity" if it passes the filter.
 func (futuresSymbol *Futures_Symbol) LOT_SIZE(quantity float64) (isValid bool, reason string, suggestion float64, err *Error) {
-
-	if futuresSymbol.Filters.LOT_SIZE == nil {
-		return true, "", quantity, nil
-	}
-
-	minQty, parseErr := strconv.ParseFloat(futuresSymbol.Filters.LOT_SIZE.MinQty, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	maxQty, parseErr := strconv.ParseFloat(futuresSymbol.Filters.LOT_SIZE.MaxQty, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	stepSize, parseErr := strconv.ParseFloat(futuresSymbol.Filters.LOT_SIZE.StepSize, 64)
+	exactQuantity, parseErr := NewDecimalFromFloat(quantity)
 	if parseErr != nil {
 		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
 	}
 
-	if minQty != 0 && quantity < minQty {
-		return false, "minQty", minQty, nil
+	isValid, reason, exactSuggestion, err := futuresSymbol.LOT_SIZE_Decimal(exactQuantity)
+	if err != nil {
+		return false, "", 0, err
 	}
-
-	if maxQty != 0 && quantity > maxQty {
-		return false, "maxQty", maxQty, nil
+	if isValid {
+		return true, "", quantity, nil
 	}
 
-	if stepSize != 0 && math.Remainder(quantity, stepSize) != 0 {
-		suggestion, parseErr := strconv.ParseFloat(Format_TickSize_str(fmt.Sprint(quantity), futuresSymbol.Filters.LOT_SIZE.StepSize), 64)
-		if parseErr != nil {
-			return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-		}
+	return false, reason, exactSuggestion.Float64(), nil
+}
 
-		return false, "stepSize", suggestion, nil
+// # Checks if the quantity passes the "LOT_SIZE", compared exactly
+//
+// Same as LOT_SIZE(), without the float drift of "quantity" % stepSize
+func (futuresSymbol *Futures_Symbol) LOT_SIZE_Decimal(quantity Decimal) (isValid bool, reason string, suggestion Decimal, err *Error) {
+	filter := futuresSymbol.Filters.LOT_SIZE
+	if filter == nil {
+		return true, "", quantity, nil
 	}
 
-	return true, "", quantity, nil
+	isValid, reason, suggestion = checkFilterRange("LOT_SIZE", "quantity", quantity, filter.MinQty, filter.MaxQty, filter.StepSize, "minQty", "maxQty", "stepSize")
+	return isValid, reason, suggestion, nil
 }
 
 // # Checks if the price passes the "LOT_SIZE"
@@ -483,47 +575,38 @@ func (futuresSymbol *Futures_Symbol) LOT_SIZE_COMPACT(price float64) (isValid bo
 // "suggestion" must be ignored if it is returned as 0.
 // "suggestion" is always returned as "quantity" if it passes the filter.
 func (futuresSymbol *Futures_Symbol) MARKET_LOT_SIZE(quantity float64) (isValid bool, reason string, suggestion float64, err *Error) {
-
-	if futuresSymbol.Filters.LOT_SIZE == nil {
-		return true, "", quantity, nil
-	}
-
-	minQty, parseErr := strconv.ParseFloat(futuresSymbol.Filters.MARKET_LOT_SIZE.MinQty, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	maxQty, parseErr := strconv.ParseFloat(futuresSymbol.Filters.MARKET_LOT_SIZE.MaxQty, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	stepSize, parseErr := strconv.ParseFloat(futuresSymbol.Filters.MARKET_LOT_SIZE.StepSize, 64)
+	exactQuantity, parseErr := NewDecimalFromFloat(quantity)
 	if parseErr != nil {
 		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
 	}
 
-	if minQty != 0 && quantity < minQty {
-		return false, "minQty", minQty, nil
+	isValid, reason, exactSuggestion, err := futuresSymbol.MARKET_LOT_SIZE_Decimal(exactQuantity)
+	if err != nil {
+		return false, "", 0, err
 	}
-
-	if maxQty != 0 && quantity > maxQty {
-		return false, "maxQty", maxQty, nil
+	if isValid {
+		return true, "", quantity, nil
 	}
 
-	if stepSize != 0 && math.Remainder(quantity, stepSize) != 0 {
-		suggestion, parseErr := strconv.ParseFloat(Format_TickSize_str(fmt.Sprint(quantity), futuresSymbol.Filters.MARKET_LOT_SIZE.StepSize), 64)
-		if parseErr != nil {
-			return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-		}
+	return false, reason, exactSuggestion.Float64(), nil
+}
 
-		return false, "stepSize", suggestion, nil
+// # Checks if the quantity passes the "MARKET_LOT_SIZE", compared exactly
+//
+// Same as MARKET_LOT_SIZE(), without the float drift of "quantity" % stepSize
+func (futuresSymbol *Futures_Symbol) MARKET_LOT_SIZE_Decimal(quantity Decimal) (isValid bool, reason string, suggestion Decimal, err *Error) {
+	filter := futuresSymbol.Filters.MARKET_LOT_SIZE
+	if filter == nil {
+		return true, "", quantity, nil
 	}
 
-	return true, "", quantity, nil
+	isValid, reason, suggestion = checkFilterRange("MARKET_LOT_SIZE", "quantity", quantity, filter.MinQty, filter.MaxQty, filter.StepSize, "minQty", "maxQty", "stepSize")
+	return isValid, reason, suggestion, nil
 }
 
 // # Checks if the price passes the "MARKET_LOT_SIZE"
 func (futuresSymbol *Futures_Symbol) MARKET_LOT_SIZE_COMPACT(price float64) (isValid bool, err *Error) {
-	isValid, _, _, err = futuresSymbol.LOT_SIZE(price)
+	isValid, _, _, err = futuresSymbol.MARKET_LOT_SIZE(price)
 	return isValid, err
 }
 
@@ -534,17 +617,17 @@ func (futuresSymbol *Futures_Symbol) MARKET_LOT_SIZE_COMPACT(price float64) (isV
 // i.e: BTCUSDT has a precision of 5, meaning if you want to buy "0.12345678" BTC,
 // it would be truncated down to "0.12345" BTC
 func (futuresSymbol *Futures_Symbol) TruncQuantity_float64(quantity float64, IsForMarketOrder bool) string {
-	return futuresSymbol.TruncQuantity(fmt.Sprint(quantity), IsForMarketOrder)
+	return futuresSymbol.TruncQuantity(strconv.FormatFloat(quantity, 'f', -1, 64), IsForMarketOrder)
 }
 
 func (futuresSymbol *Futures_Symbol) TruncQuantity(quantity string, IsForMarketOrder bool) string {
 	truncQuantity := quantity
-	if futuresSymbol.Filters.LOT_SIZE != nil && futuresSymbol.Filters.LOT_SIZE.StepSize != "" {
-		truncQuantity = Format_TickSize_str(truncQuantity, futuresSymbol.Filters.LOT_SIZE.StepSize)
+	if futuresSymbol.Filters.LOT_SIZE != nil && !futuresSymbol.Filters.LOT_SIZE.StepSize.IsZero() {
+		truncQuantity = Format_TickSize_str(truncQuantity, futuresSymbol.Filters.LOT_SIZE.StepSize.String())
 	}
 
-	if IsForMarketOrder && futuresSymbol.Filters.MARKET_LOT_SIZE != nil && futuresSymbol.Filters.MARKET_LOT_SIZE.StepSize != "" {
-		truncQuantity = Format_TickSize_str(truncQuantity, futuresSymbol.Filters.MARKET_LOT_SIZE.StepSize)
+	if IsForMarketOrder && futuresSymbol.Filters.MARKET_LOT_SIZE != nil && !futuresSymbol.Filters.MARKET_LOT_SIZE.StepSize.IsZero() {
+		truncQuantity = Format_TickSize_str(truncQuantity, futuresSymbol.Filters.MARKET_LOT_SIZE.StepSize.String())
 	}
 
 	return truncQuantity
@@ -556,7 +639,7 @@ func (futuresSymbol *Futures_Symbol) TruncQuantity(quantity string, IsForMarketO
 // i.e: BTCUSDT has a precision of 2, meaning if you want to buy BTCUSDT at "123_456.7891",
 // it would be truncated down to "123_456.78"
 func (futuresSymbol *Futures_Symbol) TruncPrice_float64(price float64) string {
-	return futuresSymbol.TruncPrice(fmt.Sprint(price))
+	return futuresSymbol.TruncPrice(strconv.FormatFloat(price, 'f', -1, 64))
 }
 
 // # Truncates a price string to the last significant digit
@@ -565,11 +648,11 @@ func (futuresSymbol *Futures_Symbol) TruncPrice_float64(price float64) string {
 // i.e: BTCUSDT has a precision of 2, meaning if you want to buy BTCUSDT at "123_456.7891",
 // it would be truncated down to "123_456.78"
 func (futuresSymbol *Futures_Symbol) TruncPrice(priceStr string) string {
-	if futuresSymbol.Filters.PRICE_FILTER == nil || futuresSymbol.Filters.PRICE_FILTER.TickSize == "" {
+	if futuresSymbol.Filters.PRICE_FILTER == nil || futuresSymbol.Filters.PRICE_FILTER.TickSize.IsZero() {
 		return priceStr
 	}
 
-	return Format_TickSize_str(priceStr, futuresSymbol.Filters.PRICE_FILTER.TickSize)
+	return Format_TickSize_str(priceStr, futuresSymbol.Filters.PRICE_FILTER.TickSize.String())
 }
 
 type Futures_SymbolFilters struct {
@@ -588,24 +671,24 @@ type Futures_ExchangeInfo_SORS struct {
 }
 
 type Futures_SymbolFilter_PRICE_FILTER struct {
-	FilterType string `json:"filterType"`
-	MinPrice   string `json:"minPrice"`
-	MaxPrice   string `json:"maxPrice"`
-	TickSize   string `json:"tickSize"`
+	FilterType string  `json:"filterType"`
+	MinPrice   Decimal `json:"minPrice"`
+	MaxPrice   Decimal `json:"maxPrice"`
+	TickSize   Decimal `json:"tickSize"`
 }
 
 type Futures_SymbolFilter_LOT_SIZE struct {
-	FilterType string `json:"filterType"`
-	MinQty     string `json:"minQty"`
-	MaxQty     string `json:"maxQty"`
-	StepSize   string `json:"stepSize"`
+	FilterType string  `json:"filterType"`
+	MinQty     Decimal `json:"minQty"`
+	MaxQty     Decimal `json:"maxQty"`
+	StepSize   Decimal `json:"stepSize"`
 }
 
 type Futures_SymbolFilter_MARKET_LOT_SIZE struct {
-	FilterType string `json:"filterType"`
-	MinQty     string `json:"minQty"`
-	MaxQty     string `json:"maxQty"`
-	StepSize   string `json:"stepSize"`
+	FilterType string  `json:"filterType"`
+	MinQty     Decimal `json:"minQty"`
+	MaxQty     Decimal `json:"maxQty"`
+	StepSize   Decimal `json:"stepSize"`
 }
 
 type Futures_SymbolFilter_MAX_NUM_ORDERS struct {
@@ -658,55 +741,55 @@ type Futures_ExchangeInfo struct {
 }
 
 type Futures_OrderBook struct {
-	LastUpdateId int64       `json:"lastUpdateId"`
-	Time         int64       `json:"E"`
-	TransactTime int64       `json:"T"`
-	Bids         [][2]string `json:"bids"`
-	Asks         [][2]string `json:"asks"`
+	LastUpdateId int64        `json:"lastUpdateId"`
+	Time         int64        `json:"E"`
+	TransactTime int64        `json:"T"`
+	Bids         [][2]Decimal `json:"bids"`
+	Asks         [][2]Decimal `json:"asks"`
 }
 
 type Futures_Trade struct {
-	Id           int64  `json:"id"`
-	Price        string `json:"price"`
-	Qty          string `json:"qty"`
-	QuoteQty     string `json:"quoteQty"`
-	Timestamp    int64  `json:"time"`
-	IsBuyerMaker bool   `json:"isBuyerMaker"`
+	Id           int64   `json:"id"`
+	Price        Decimal `json:"price"`
+	Qty          Decimal `json:"qty"`
+	QuoteQty     Decimal `json:"quoteQty"`
+	Timestamp    int64   `json:"time"`
+	IsBuyerMaker bool    `json:"isBuyerMaker"`
 }
 
 type Futures_AggTrade struct {
-	AggTradeId   int64  `json:"a"`
-	Price        string `json:"p"`
-	Qty          string `json:"q"`
-	FirstTradeId int64  `json:"f"`
-	LastTradeId  int64  `json:"l"`
-	Timestamp    int64  `json:"T"`
-	IsBuyerMaker bool   `json:"m"`
+	AggTradeId   int64   `json:"a"`
+	Price        Decimal `json:"p"`
+	Qty          Decimal `json:"q"`
+	FirstTradeId int64   `json:"f"`
+	LastTradeId  int64   `json:"l"`
+	Timestamp    int64   `json:"T"`
+	IsBuyerMaker bool    `json:"m"`
 }
 
 type Futures_Candlestick struct {
 	// Kline open time
 	OpenTime int64
 	// Open price
-	Open string
+	Open Decimal
 	// High price
-	High string
+	High Decimal
 	// Low price
-	Low string
+	Low Decimal
 	// Close price
-	Close string
+	Close Decimal
 	// Volume
-	Volume string
+	Volume Decimal
 	// Kline Close time
 	CloseTime int64
 	// Quote asset volume
-	QuoteAssetVolume string
+	QuoteAssetVolume Decimal
 	// Number of trades
 	TradeCount int64
 	// Taker buy base asset volume
-	TakerBuyBaseAssetVolume string
+	TakerBuyBaseAssetVolume Decimal
 	// Taker buy quote asset volume
-	TakerBuyQuoteAssetVolume string
+	TakerBuyQuoteAssetVolume Decimal
 	// Unused field, ignore.
 	Unused string
 }
@@ -715,13 +798,13 @@ type Futures_PriceCandlestick struct {
 	// Kline open time
 	OpenTime int64
 	// Open price
-	Open string
+	Open Decimal
 	// High price
-	High string
+	High Decimal
 	// Low price
-	Low string
+	Low Decimal
 	// Close price
-	Close string
+	Close Decimal
 	// Volume
 	Ignore1 string
 	// Kline Close time
@@ -739,55 +822,63 @@ type Futures_PriceCandlestick struct {
 }
 
 type Futures_MarkPrice struct {
-	Symbol               string `json:"symbol"`
-	MarkPrice            string `json:"markPrice"`
-	IndexPrice           string `json:"indexPrice"`
-	EstimatedSettlePrice string `json:"estimatedSettlePrice"`
-	LastFundingRate      string `json:"lastFundingRate"`
-	NextFundingTime      int64  `json:"nextFundingTime"`
-	InterestRate         string `json:"interestRate"`
-	Time                 int64  `json:"time"`
+	Symbol               string  `json:"symbol"`
+	MarkPrice            Decimal `json:"markPrice"`
+	IndexPrice           Decimal `json:"indexPrice"`
+	EstimatedSettlePrice Decimal `json:"estimatedSettlePrice"`
+	LastFundingRate      string  `json:"lastFundingRate"`
+	NextFundingTime      int64   `json:"nextFundingTime"`
+	InterestRate         string  `json:"interestRate"`
+	Time                 int64   `json:"time"`
 }
 
 type Futures_FundingRate struct {
-	Symbol      string `json:"symbol"`
-	FundingRate string `json:"fundingRate"`
-	FundingTime int64  `json:"fundingTime"`
-	MarkPrice   string `json:"markPrice"`
+	Symbol      string  `json:"symbol"`
+	FundingRate string  `json:"fundingRate"`
+	FundingTime int64   `json:"fundingTime"`
+	MarkPrice   Decimal `json:"markPrice"`
+}
+
+type Futures_FundingInfo struct {
+	Symbol                   string `json:"symbol"`
+	AdjustedFundingRateCap   string `json:"adjustedFundingRateCap"`
+	AdjustedFundingRateFloor string `json:"adjustedFundingRateFloor"`
+	FundingIntervalHours     int64  `json:"fundingIntervalHours"`
+	Disclaimer               bool   `json:"disclaimer"`
 }
 
 type Futures_24hTicker struct {
-	Symbol             string `json:"symbol"`
-	PriceChange        string `json:"priceChange"`
-	PriceChangePercent string `json:"priceChangePercent"`
-	WeightedAvgPrice   string `json:"weightedAvgPrice"`
-	LastPrice          string `json:"lastPrice"`
-	LastQty            string `json:"lastQty"`
-	Open               string `json:"openPrice"`
-	High               string `json:"highPrice"`
-	Low                string `json:"lowPrice"`
-	Volume             string `json:"volume"`
-	QuoteVolume        string `json:"quoteVolume"`
-	OpenTime           int64  `json:"openTime"`
-	CloseTime          int64  `json:"closeTime"`
-	FirstId            int64  `json:"firstId"`
-	LastId             int64  `json:"lastId"`
-	Count              int64  `json:"count"`
+	Symbol             string  `json:"symbol"`
+	PriceChange        Decimal `json:"priceChange"`
+	PriceChangePercent Decimal `json:"priceChangePercent"`
+	WeightedAvgPrice   Decimal `json:"weightedAvgPrice"`
+	LastPrice          Decimal `json:"lastPrice"`
+	LastQty            Decimal `json:"lastQty"`
+	Open               string  `json:"openPrice"`
+	High               string  `json:"highPrice"`
+	Low                string  `json:"lowPrice"`
+	Volume             Decimal `json:"volume"`
+	QuoteVolume        Decimal `json:"quoteVolume"`
+	OpenTime           int64   `json:"openTime"`
+	CloseTime          int64   `json:"closeTime"`
+	FirstId            int64   `json:"firstId"`
+	LastId             int64   `json:"lastId"`
+	Count              int64   `json:"count"`
 }
 
 type Futures_PriceTicker struct {
-	Symbol string `json:"symbol"`
-	Price  string `json:"price"`
-	Time   int64  `json:"time"`
+	Symbol string  `json:"symbol"`
+	Price  Decimal `json:"price"`
+	Time   int64   `json:"time"`
 }
 
 type Futures_BookTicker struct {
-	Symbol   string `json:"symbol"`
-	BidPrice string `json:"bidPrice"`
-	BidQty   string `json:"bidQty"`
-	AskPrice string `json:"askPrice"`
-	AskQty   string `json:"askQty"`
-	Time     int64  `json:"time"`
+	Symbol   string  `json:"symbol"`
+	BidPrice Decimal `json:"bidPrice"`
+	BidQty   Decimal `json:"bidQty"`
+	AskPrice Decimal `json:"askPrice"`
+	AskQty   Decimal `json:"askQty"`
+	Time     int64   `json:"time"`
 }
 
 type Futures_DeliveryPrice struct {
@@ -808,6 +899,70 @@ type Futures_OpenInterestStatistics struct {
 	Timestamp            string `json:"timestamp"`
 }
 
+type Futures_LongShortRatio struct {
+	Symbol         string `json:"symbol"`
+	LongShortRatio string `json:"longShortRatio"`
+	LongAccount    string `json:"longAccount"`
+	ShortAccount   string `json:"shortAccount"`
+	Timestamp      int64  `json:"timestamp"`
+}
+
+type Futures_TakerBuySellVolume struct {
+	BuySellRatio string `json:"buySellRatio"`
+	BuyVol       string `json:"buyVol"`
+	SellVol      string `json:"sellVol"`
+	Timestamp    int64  `json:"timestamp"`
+}
+
+type Futures_Basis struct {
+	Pair                string  `json:"pair"`
+	ContractType        string  `json:"contractType"`
+	IndexPrice          Decimal `json:"indexPrice"`
+	FuturesPrice        Decimal `json:"futuresPrice"`
+	Basis               string  `json:"basis"`
+	BasisRate           string  `json:"basisRate"`
+	AnnualizedBasisRate string  `json:"annualizedBasisRate"`
+	Timestamp           int64   `json:"timestamp"`
+}
+
+type Futures_IndexConstituents struct {
+	Symbol       string                                   `json:"symbol"`
+	Time         int64                                    `json:"time"`
+	Constituents []*Futures_IndexConstituents_Constituent `json:"constituents"`
+}
+
+type Futures_IndexConstituents_Constituent struct {
+	Exchange string  `json:"exchange"`
+	Symbol   string  `json:"symbol"`
+	Price    Decimal `json:"price"`
+	Weight   string  `json:"weight"`
+}
+
+type Futures_InsuranceBalance struct {
+	Symbols []string                          `json:"symbols"`
+	Assets  []*Futures_InsuranceBalance_Asset `json:"assets"`
+}
+
+type Futures_InsuranceBalance_Asset struct {
+	Asset         string `json:"asset"`
+	MarginBalance string `json:"marginBalance"`
+	UpdateTime    int64  `json:"updateTime"`
+}
+
+type Futures_AssetIndex struct {
+	Symbol                string `json:"symbol"`
+	Time                  int64  `json:"time"`
+	Index                 string `json:"index"`
+	BidBuffer             string `json:"bidBuffer"`
+	AskBuffer             string `json:"askBuffer"`
+	BidRate               string `json:"bidRate"`
+	AskRate               string `json:"askRate"`
+	AutoExchangeBidBuffer string `json:"autoExchangeBidBuffer"`
+	AutoExchangeAskBuffer string `json:"autoExchangeAskBuffer"`
+	AutoExchangeBidRate   string `json:"autoExchangeBidRate"`
+	AutoExchangeAskRate   string `json:"autoExchangeAskRate"`
+}
+
 //////////////////////////////////////
 //////////////////////////////////////
 //////////////////////////////////////
@@ -815,19 +970,19 @@ type Futures_OpenInterestStatistics struct {
 type Futures_Order struct {
 	ClientOrderId string `json:"clientOrderId"`
 
-	CumQty string `json:"cumQty"`
+	CumQty Decimal `json:"cumQty"`
 
 	CumQuote string `json:"cumQuote"`
 
-	ExecutedQty string `json:"executedQty"`
+	ExecutedQty Decimal `json:"executedQty"`
 
 	OrderId int64 `json:"orderId"`
 
-	AvgPrice string `json:"avgPrice"`
+	AvgPrice Decimal `json:"avgPrice"`
 
-	OrigQty string `json:"origQty"`
+	OrigQty Decimal `json:"origQty"`
 
-	Price string `json:"price"`
+	Price Decimal `json:"price"`
 
 	ReduceOnly bool `json:"reduceOnly"`
 
@@ -838,7 +993,7 @@ type Futures_Order struct {
 	Status string `json:"status"`
 
 	// please ignore when order type is "TRAILING_STOP_MARKET"
-	StopPrice string `json:"stopPrice"`
+	StopPrice Decimal `json:"stopPrice"`
 
 	// if Close-All
 	ClosePosition bool `json:"closePosition"`
@@ -852,10 +1007,10 @@ type Futures_Order struct {
 	OrigType string `json:"origType"`
 
 	// activation price, only return with "TRAILING_STOP_MARKET" order
-	ActivatePrice string `json:"activatePrice"`
+	ActivatePrice Decimal `json:"activatePrice"`
 
 	// callback rate, only return with "TRAILING_STOP_MARKET" order
-	PriceRate string `json:"priceRate"`
+	PriceRate Decimal `json:"priceRate"`
 
 	UpdateTime int64 `json:"updateTime"`
 
@@ -966,6 +1121,10 @@ type Futures_AccountInfo_Position struct {
 	UpdateTime       int64  `json:"updateTime"`
 }
 
+type Futures_ListenKey struct {
+	ListenKey string `json:"listenKey"`
+}
+
 type Futures_UserCommissionRate struct {
 	Symbol              string `json:"symbol"`
 	MakerCommissionRate string `json:"makerCommissionRate"`
@@ -1016,72 +1175,20 @@ type Futures_LeverageBrackets_Bracket struct {
 ///////////////////////
 ///////////////////////
 
-func parseFloat_Futures_Candlestick(candlestick *Futures_Candlestick) (*FuturesWS_Candlestick_Float64, error) {
-
-	open, err := ParseFloat(candlestick.Open)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'candlestick.Open' in parseFloat_Futures_Candlestick: %s", candlestick.Open, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-	high, err := ParseFloat(candlestick.High)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'candlestick.High' in parseFloat_Futures_Candlestick: %s", candlestick.High, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-	low, err := ParseFloat(candlestick.Low)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'candlestick.Low' in parseFloat_Futures_Candlestick: %s", candlestick.Low, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-	close, err := ParseFloat(candlestick.Close)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'candlestick.Close' in parseFloat_Futures_Candlestick: %s", candlestick.Close, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-
-	baseAssetVolune, err := ParseFloat(candlestick.Volume)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'candlestick.Volume' in parseFloat_Futures_Candlestick: %s", candlestick.Volume, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-	quoteAssetVolume, err := ParseFloat(candlestick.QuoteAssetVolume)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'candlestick.QuoteAssetVolume' in parseFloat_Futures_Candlestick: %s", candlestick.QuoteAssetVolume, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-
-	takerBuyBaseAssetVolume, err := ParseFloat(candlestick.TakerBuyBaseAssetVolume)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'candlestick.TakerBuyBaseAssetVolume' in parseFloat_Futures_Candlestick: %s", candlestick.TakerBuyBaseAssetVolume, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-	takerBuyQuoteAssetVolume, err := ParseFloat(candlestick.TakerBuyQuoteAssetVolume)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'candlestick.TakerBuyQuoteAssetVolume' in parseFloat_Futures_Candlestick: %s", candlestick.TakerBuyQuoteAssetVolume, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-
+func parseFloat_Futures_Candlestick(candlestick *Futures_Candlestick) *FuturesWS_Candlestick_Float64 {
 	return &FuturesWS_Candlestick_Float64{
 		OpenTime:  candlestick.OpenTime,
 		CloseTime: candlestick.CloseTime,
 
-		Open:  open,
-		High:  high,
-		Low:   low,
-		Close: close,
+		Open:  candlestick.Open.Float64(),
+		High:  candlestick.High.Float64(),
+		Low:   candlestick.Low.Float64(),
+		Close: candlestick.Close.Float64(),
 
-		Volume:                   baseAssetVolune,
-		QuoteAssetVolume:         quoteAssetVolume,
-		TakerBuyBaseAssetVolume:  takerBuyBaseAssetVolume,
-		TakerBuyQuoteAssetVolume: takerBuyQuoteAssetVolume,
+		Volume:                   candlestick.Volume.Float64(),
+		QuoteAssetVolume:         candlestick.QuoteAssetVolume.Float64(),
+		TakerBuyBaseAssetVolume:  candlestick.TakerBuyBaseAssetVolume.Float64(),
+		TakerBuyQuoteAssetVolume: candlestick.TakerBuyQuoteAssetVolume.Float64(),
 		TradeCount:               candlestick.TradeCount,
-	}, nil
+	}
 }
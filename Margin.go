@@ -0,0 +1,1245 @@
+package Binance
+
+import (
+	"fmt"
+	"strings"
+)
+
+// # Cross and Isolated Margin
+//
+// Every isolated margin call takes an "IsIsolated" param (or an isolated symbol), cross margin is used otherwise.
+type Margin struct {
+	binance       *Binance
+	requestClient RequestClient
+	baseURL       string
+
+	API APIKEYS
+
+	Websockets Margin_Websockets
+}
+
+func (margin *Margin) init(binance *Binance) {
+	margin.binance = binance
+
+	margin.requestClient.init(binance)
+	margin.requestClient.Set_APIKEY(binance.API.KEY, binance.API.SECRET)
+	margin.baseURL = MARGIN_Constants.URLs[0]
+
+	margin.API.Set(binance.API.KEY, binance.API.SECRET)
+
+	margin.Websockets.binance = binance
+}
+
+// Isolated margin endpoints expect "TRUE" or "FALSE"
+func setIsIsolated(opts map[string]interface{}, isIsolated bool) {
+	if isIsolated {
+		opts["isIsolated"] = "TRUE"
+	}
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+// //////////////////////////// Borrow/Repay \\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\
+
+type Margin_BorrowRepay_Params struct {
+	IsIsolated bool
+	// Required for isolated margin
+	Symbol     string
+	RecvWindow int64
+}
+
+func (margin *Margin) borrowRepay(Type string, asset string, amount string, opt_params ...Margin_BorrowRepay_Params) (*Margin_Transaction, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["asset"] = asset
+	opts["amount"] = amount
+	opts["type"] = Type
+	opts["isIsolated"] = "FALSE"
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		setIsIsolated(opts, params.IsIsolated)
+		if IsDifferentFromDefault(params.Symbol) {
+			opts["symbol"] = params.Symbol
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.MARGIN,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/margin/borrow-repay",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var transaction *Margin_Transaction
+	processingErr := json.Unmarshal(resp.Body, &transaction)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return transaction, resp, nil
+}
+
+// # Margin account borrow
+//
+// Weight: 1500
+func (margin *Margin) Borrow(asset string, amount string, opt_params ...Margin_BorrowRepay_Params) (*Margin_Transaction, *Response, *Error) {
+	return margin.borrowRepay(MARGIN_Constants.BorrowRepayTypes.BORROW, asset, amount, opt_params...)
+}
+
+// # Margin account repay
+//
+// Weight: 1500
+func (margin *Margin) Repay(asset string, amount string, opt_params ...Margin_BorrowRepay_Params) (*Margin_Transaction, *Response, *Error) {
+	return margin.borrowRepay(MARGIN_Constants.BorrowRepayTypes.REPAY, asset, amount, opt_params...)
+}
+
+type Margin_BorrowRepayHistory_Params struct {
+	Asset string
+	// Only for isolated margin
+	IsolatedSymbol string
+	TxId           int64
+	StartTime      int64
+	EndTime        int64
+	// Currently querying page, starts from 1, default 1
+	Current int64
+	// Default 10, max 100
+	Size       int64
+	RecvWindow int64
+}
+
+// # Query borrow/repay records in Margin account
+//
+// "Type" is one of MARGIN_Constants.BorrowRepayTypes
+//
+// Weight: 10
+func (margin *Margin) BorrowRepayHistory(Type string, opt_params ...Margin_BorrowRepayHistory_Params) (*Margin_BorrowRepayHistory, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["type"] = Type
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Asset) {
+			opts["asset"] = params.Asset
+		}
+		if IsDifferentFromDefault(params.IsolatedSymbol) {
+			opts["isolatedSymbol"] = params.IsolatedSymbol
+		}
+		if IsDifferentFromDefault(params.TxId) {
+			opts["txId"] = params.TxId
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.Current) {
+			opts["current"] = params.Current
+		}
+		if IsDifferentFromDefault(params.Size) {
+			opts["size"] = params.Size
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/margin/borrow-repay",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var history *Margin_BorrowRepayHistory
+	processingErr := json.Unmarshal(resp.Body, &history)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return history, resp, nil
+}
+
+// \\\\\\\\\\\\\\\\\\\\\\\\\\\ Borrow/Repay ////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+// //////////////////////////// Orders \\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\
+
+func (margin *Margin) newOrder(opts map[string]interface{}) (*Margin_Order, *Response, *Error) {
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.TRADE,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/margin/order",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var order *Margin_Order
+	processingErr := json.Unmarshal(resp.Body, &order)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return order, resp, nil
+}
+
+type Margin_Order_Params struct {
+	IsIsolated       bool
+	TimeInForce      string
+	Quantity         string
+	QuoteOrderQty    string
+	Price            string
+	StopPrice        string
+	NewClientOrderId string
+	IcebergQty       string
+	NewOrderRespType string
+	// One of MARGIN_Constants.SideEffectTypes, default "NO_SIDE_EFFECT"
+	SideEffectType          string
+	SelfTradePreventionMode string
+	// Only for "AUTO_REPAY" and "AUTO_BORROW_REPAY" orders, repays the borrowed amount if the order is cancelled, default true
+	AutoRepayAtCancel *bool
+	RecvWindow        int64
+}
+
+// # Margin Account New Order
+//
+// Weight: 6 (UID)
+func (margin *Margin) NewOrder(symbol string, side string, Type string, opt_params ...Margin_Order_Params) (*Margin_Order, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	opts["side"] = side
+	opts["type"] = Type
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		setIsIsolated(opts, params.IsIsolated)
+		if IsDifferentFromDefault(params.TimeInForce) {
+			opts["timeInForce"] = params.TimeInForce
+		}
+		if IsDifferentFromDefault(params.Quantity) {
+			opts["quantity"] = params.Quantity
+		}
+		if IsDifferentFromDefault(params.QuoteOrderQty) {
+			opts["quoteOrderQty"] = params.QuoteOrderQty
+		}
+		if IsDifferentFromDefault(params.Price) {
+			opts["price"] = params.Price
+		}
+		if IsDifferentFromDefault(params.StopPrice) {
+			opts["stopPrice"] = params.StopPrice
+		}
+		if IsDifferentFromDefault(params.NewClientOrderId) {
+			opts["newClientOrderId"] = params.NewClientOrderId
+		}
+		if IsDifferentFromDefault(params.IcebergQty) {
+			opts["icebergQty"] = params.IcebergQty
+		}
+		if IsDifferentFromDefault(params.NewOrderRespType) {
+			opts["newOrderRespType"] = params.NewOrderRespType
+		}
+		if IsDifferentFromDefault(params.SideEffectType) {
+			opts["sideEffectType"] = params.SideEffectType
+		}
+		if IsDifferentFromDefault(params.SelfTradePreventionMode) {
+			opts["selfTradePreventionMode"] = params.SelfTradePreventionMode
+		}
+		if params.AutoRepayAtCancel != nil {
+			opts["autoRepayAtCancel"] = *params.AutoRepayAtCancel
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	return margin.newOrder(opts)
+}
+
+///////////////////////// LIMIT \\\\\\\\\\\\\\\\\\\\\\\\\\\\
+
+type Margin_LimitOrder_Params struct {
+	IsIsolated              bool
+	TimeInForce             string
+	NewClientOrderId        string
+	IcebergQty              string
+	NewOrderRespType        string
+	SideEffectType          string
+	SelfTradePreventionMode string
+	AutoRepayAtCancel       *bool
+	RecvWindow              int64
+}
+
+// "timeInForce" defaults to "GTC"
+func (margin *Margin) LimitOrder(symbol string, side string, price string, quantity string, opt_params ...Margin_LimitOrder_Params) (*Margin_Order, *Response, *Error) {
+	params := Margin_Order_Params{
+		TimeInForce: "GTC",
+		Price:       price,
+		Quantity:    quantity,
+	}
+
+	if len(opt_params) != 0 {
+		limitParams := opt_params[0]
+		params.IsIsolated = limitParams.IsIsolated
+		if IsDifferentFromDefault(limitParams.TimeInForce) {
+			params.TimeInForce = limitParams.TimeInForce
+		}
+		params.NewClientOrderId = limitParams.NewClientOrderId
+		params.IcebergQty = limitParams.IcebergQty
+		params.NewOrderRespType = limitParams.NewOrderRespType
+		params.SideEffectType = limitParams.SideEffectType
+		params.SelfTradePreventionMode = limitParams.SelfTradePreventionMode
+		params.AutoRepayAtCancel = limitParams.AutoRepayAtCancel
+		params.RecvWindow = limitParams.RecvWindow
+	}
+
+	return margin.NewOrder(symbol, side, "LIMIT", params)
+}
+
+func (margin *Margin) LimitBuy(symbol string, price string, quantity string, opt_params ...Margin_LimitOrder_Params) (*Margin_Order, *Response, *Error) {
+	return margin.LimitOrder(symbol, "BUY", price, quantity, opt_params...)
+}
+
+func (margin *Margin) LimitSell(symbol string, price string, quantity string, opt_params ...Margin_LimitOrder_Params) (*Margin_Order, *Response, *Error) {
+	return margin.LimitOrder(symbol, "SELL", price, quantity, opt_params...)
+}
+
+///////////////////////// MARKET \\\\\\\\\\\\\\\\\\\\\\\\\\\\
+
+type Margin_MarketOrder_Params struct {
+	IsIsolated              bool
+	NewClientOrderId        string
+	NewOrderRespType        string
+	SideEffectType          string
+	SelfTradePreventionMode string
+	AutoRepayAtCancel       *bool
+	RecvWindow              int64
+}
+
+// "orderValue" is the quantity in base asset if "is_OrderValue_in_BaseAsset" is true, otherwise it is the quote asset amount to spend/receive
+func (margin *Margin) MarketOrder(symbol string, side string, orderValue string, is_OrderValue_in_BaseAsset bool, opt_params ...Margin_MarketOrder_Params) (*Margin_Order, *Response, *Error) {
+	var params Margin_Order_Params
+
+	if is_OrderValue_in_BaseAsset {
+		params.Quantity = orderValue
+	} else {
+		params.QuoteOrderQty = orderValue
+	}
+
+	if len(opt_params) != 0 {
+		marketParams := opt_params[0]
+		params.IsIsolated = marketParams.IsIsolated
+		params.NewClientOrderId = marketParams.NewClientOrderId
+		params.NewOrderRespType = marketParams.NewOrderRespType
+		params.SideEffectType = marketParams.SideEffectType
+		params.SelfTradePreventionMode = marketParams.SelfTradePreventionMode
+		params.AutoRepayAtCancel = marketParams.AutoRepayAtCancel
+		params.RecvWindow = marketParams.RecvWindow
+	}
+
+	return margin.NewOrder(symbol, side, "MARKET", params)
+}
+
+func (margin *Margin) MarketBuy(symbol string, orderValue string, is_OrderValue_in_BaseAsset bool, opt_params ...Margin_MarketOrder_Params) (*Margin_Order, *Response, *Error) {
+	return margin.MarketOrder(symbol, "BUY", orderValue, is_OrderValue_in_BaseAsset, opt_params...)
+}
+
+func (margin *Margin) MarketSell(symbol string, orderValue string, is_OrderValue_in_BaseAsset bool, opt_params ...Margin_MarketOrder_Params) (*Margin_Order, *Response, *Error) {
+	return margin.MarketOrder(symbol, "SELL", orderValue, is_OrderValue_in_BaseAsset, opt_params...)
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// Either "OrderId" or "OrigClientOrderId" must be sent
+type Margin_OrderIdentifier_Params struct {
+	IsIsolated        bool
+	OrderId           int64
+	OrigClientOrderId string
+	// Only used on cancellations, used to uniquely identify this cancel
+	NewClientOrderId string
+	RecvWindow       int64
+}
+
+func (margin *Margin) orderIdentifierRequest(method string, securityType string, symbol string, params Margin_OrderIdentifier_Params) (*Margin_Order, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	setIsIsolated(opts, params.IsIsolated)
+	if IsDifferentFromDefault(params.OrderId) {
+		opts["orderId"] = params.OrderId
+	}
+	if IsDifferentFromDefault(params.OrigClientOrderId) {
+		opts["origClientOrderId"] = params.OrigClientOrderId
+	}
+	if IsDifferentFromDefault(params.NewClientOrderId) && method == Constants.Methods.DELETE {
+		opts["newClientOrderId"] = params.NewClientOrderId
+	}
+	if IsDifferentFromDefault(params.RecvWindow) {
+		opts["recvWindow"] = params.RecvWindow
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: securityType,
+		method:       method,
+		url:          "/sapi/v1/margin/order",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var order *Margin_Order
+	processingErr := json.Unmarshal(resp.Body, &order)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return order, resp, nil
+}
+
+// # Query Margin Account's Order
+//
+// Weight: 10
+func (margin *Margin) QueryOrder(symbol string, params Margin_OrderIdentifier_Params) (*Margin_Order, *Response, *Error) {
+	return margin.orderIdentifierRequest(Constants.Methods.GET, MARGIN_Constants.SecurityTypes.USER_DATA, symbol, params)
+}
+
+// # Margin Account Cancel Order
+//
+// Weight: 10
+func (margin *Margin) CancelOrder(symbol string, params Margin_OrderIdentifier_Params) (*Margin_Order, *Response, *Error) {
+	return margin.orderIdentifierRequest(Constants.Methods.DELETE, MARGIN_Constants.SecurityTypes.TRADE, symbol, params)
+}
+
+type Margin_CancelAllOrders_Params struct {
+	IsIsolated bool
+	RecvWindow int64
+}
+
+// # Margin Account Cancel all Open Orders on a Symbol
+//
+// # OCO orders are cancelled along with the regular orders, their legs are returned as regular orders
+//
+// Weight: 1
+func (margin *Margin) CancelAllOrders(symbol string, opt_params ...Margin_CancelAllOrders_Params) ([]*Margin_Order, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		setIsIsolated(opts, params.IsIsolated)
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.TRADE,
+		method:       Constants.Methods.DELETE,
+		url:          "/sapi/v1/margin/openOrders",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var orders []*Margin_Order
+	processingErr := json.Unmarshal(resp.Body, &orders)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return orders, resp, nil
+}
+
+type Margin_OpenOrders_Params struct {
+	// Required for isolated margin
+	Symbol     string
+	IsIsolated bool
+	RecvWindow int64
+}
+
+// # Query Margin Account's Open Orders
+//
+// Weight: 10, 40 when the symbol is omitted
+func (margin *Margin) OpenOrders(opt_params ...Margin_OpenOrders_Params) ([]*Margin_Order, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Symbol) {
+			opts["symbol"] = params.Symbol
+		}
+		setIsIsolated(opts, params.IsIsolated)
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/margin/openOrders",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var orders []*Margin_Order
+	processingErr := json.Unmarshal(resp.Body, &orders)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return orders, resp, nil
+}
+
+type Margin_AllOrders_Params struct {
+	IsIsolated bool
+	OrderId    int64
+	StartTime  int64
+	EndTime    int64
+	// Default 500; max 500.
+	Limit      int64
+	RecvWindow int64
+}
+
+// # Query Margin Account's All Orders
+//
+// Weight: 200
+func (margin *Margin) AllOrders(symbol string, opt_params ...Margin_AllOrders_Params) ([]*Margin_Order, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		setIsIsolated(opts, params.IsIsolated)
+		if IsDifferentFromDefault(params.OrderId) {
+			opts["orderId"] = params.OrderId
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/margin/allOrders",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var orders []*Margin_Order
+	processingErr := json.Unmarshal(resp.Body, &orders)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return orders, resp, nil
+}
+
+///////////////////////// OCO \\\\\\\\\\\\\\\\\\\\\\\\\\\\
+
+type Margin_OCO_Params struct {
+	IsIsolated         bool
+	ListClientOrderId  string
+	LimitClientOrderId string
+	LimitIcebergQty    string
+	StopClientOrderId  string
+	// If provided, "StopLimitTimeInForce" is required
+	StopLimitPrice          string
+	StopIcebergQty          string
+	StopLimitTimeInForce    string
+	NewOrderRespType        string
+	SideEffectType          string
+	SelfTradePreventionMode string
+	AutoRepayAtCancel       *bool
+	RecvWindow              int64
+}
+
+// # Margin Account New OCO
+//
+// "price" is the limit leg's price, "stopPrice" is the stop leg's trigger price
+//
+// Weight: 6 (UID)
+func (margin *Margin) NewOCO(symbol string, side string, quantity string, price string, stopPrice string, opt_params ...Margin_OCO_Params) (*Margin_OCO, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	opts["side"] = side
+	opts["quantity"] = quantity
+	opts["price"] = price
+	opts["stopPrice"] = stopPrice
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		setIsIsolated(opts, params.IsIsolated)
+		if IsDifferentFromDefault(params.ListClientOrderId) {
+			opts["listClientOrderId"] = params.ListClientOrderId
+		}
+		if IsDifferentFromDefault(params.LimitClientOrderId) {
+			opts["limitClientOrderId"] = params.LimitClientOrderId
+		}
+		if IsDifferentFromDefault(params.LimitIcebergQty) {
+			opts["limitIcebergQty"] = params.LimitIcebergQty
+		}
+		if IsDifferentFromDefault(params.StopClientOrderId) {
+			opts["stopClientOrderId"] = params.StopClientOrderId
+		}
+		if IsDifferentFromDefault(params.StopLimitPrice) {
+			opts["stopLimitPrice"] = params.StopLimitPrice
+		}
+		if IsDifferentFromDefault(params.StopIcebergQty) {
+			opts["stopIcebergQty"] = params.StopIcebergQty
+		}
+		if IsDifferentFromDefault(params.StopLimitTimeInForce) {
+			opts["stopLimitTimeInForce"] = params.StopLimitTimeInForce
+		}
+		if IsDifferentFromDefault(params.NewOrderRespType) {
+			opts["newOrderRespType"] = params.NewOrderRespType
+		}
+		if IsDifferentFromDefault(params.SideEffectType) {
+			opts["sideEffectType"] = params.SideEffectType
+		}
+		if IsDifferentFromDefault(params.SelfTradePreventionMode) {
+			opts["selfTradePreventionMode"] = params.SelfTradePreventionMode
+		}
+		if params.AutoRepayAtCancel != nil {
+			opts["autoRepayAtCancel"] = *params.AutoRepayAtCancel
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.TRADE,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/margin/order/oco",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var oco *Margin_OCO
+	processingErr := json.Unmarshal(resp.Body, &oco)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return oco, resp, nil
+}
+
+// Either "OrderListId" or "ListClientOrderId" must be sent
+type Margin_OCOIdentifier_Params struct {
+	IsIsolated        bool
+	OrderListId       int64
+	ListClientOrderId string
+	// Only used on cancellations, used to uniquely identify this cancel
+	NewClientOrderId string
+	RecvWindow       int64
+}
+
+func (margin *Margin) ocoIdentifierRequest(method string, securityType string, opts map[string]interface{}, params Margin_OCOIdentifier_Params) (*Margin_OCO, *Response, *Error) {
+	setIsIsolated(opts, params.IsIsolated)
+	if IsDifferentFromDefault(params.OrderListId) {
+		opts["orderListId"] = params.OrderListId
+	}
+	if IsDifferentFromDefault(params.ListClientOrderId) {
+		opts["listClientOrderId"] = params.ListClientOrderId
+	}
+	if IsDifferentFromDefault(params.NewClientOrderId) && method == Constants.Methods.DELETE {
+		opts["newClientOrderId"] = params.NewClientOrderId
+	}
+	if IsDifferentFromDefault(params.RecvWindow) {
+		opts["recvWindow"] = params.RecvWindow
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: securityType,
+		method:       method,
+		url:          "/sapi/v1/margin/orderList",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var oco *Margin_OCO
+	processingErr := json.Unmarshal(resp.Body, &oco)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return oco, resp, nil
+}
+
+// # Margin Account Cancel OCO
+//
+// Weight: 1
+func (margin *Margin) CancelOCO(symbol string, params Margin_OCOIdentifier_Params) (*Margin_OCO, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["symbol"] = symbol
+
+	return margin.ocoIdentifierRequest(Constants.Methods.DELETE, MARGIN_Constants.SecurityTypes.TRADE, opts, params)
+}
+
+// # Query Margin Account's OCO
+//
+// "IsolatedSymbol" is required for isolated margin OCOs
+//
+// Weight: 10
+func (margin *Margin) QueryOCO(params Margin_OCOIdentifier_Params, isolatedSymbol ...string) (*Margin_OCO, *Response, *Error) {
+	opts := make(map[string]interface{})
+	if len(isolatedSymbol) != 0 {
+		opts["symbol"] = isolatedSymbol[0]
+	}
+
+	return margin.ocoIdentifierRequest(Constants.Methods.GET, MARGIN_Constants.SecurityTypes.USER_DATA, opts, params)
+}
+
+// # Query Margin Account's Open OCO
+//
+// Weight: 10
+func (margin *Margin) OpenOCOs(opt_params ...Margin_OpenOrders_Params) ([]*Margin_OCO, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Symbol) {
+			opts["symbol"] = params.Symbol
+		}
+		setIsIsolated(opts, params.IsIsolated)
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/margin/openOrderList",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var ocos []*Margin_OCO
+	processingErr := json.Unmarshal(resp.Body, &ocos)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return ocos, resp, nil
+}
+
+// \\\\\\\\\\\\\\\\\\\\\\\\\\\ Orders ////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Margin_Trades_Params struct {
+	IsIsolated bool
+	OrderId    int64
+	StartTime  int64
+	EndTime    int64
+	FromId     int64
+	// Default 500; max 1000.
+	Limit      int64
+	RecvWindow int64
+}
+
+// # Query Margin Account's Trade List
+//
+// Weight: 10
+func (margin *Margin) Trades(symbol string, opt_params ...Margin_Trades_Params) ([]*Margin_Trade, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		setIsIsolated(opts, params.IsIsolated)
+		if IsDifferentFromDefault(params.OrderId) {
+			opts["orderId"] = params.OrderId
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.FromId) {
+			opts["fromId"] = params.FromId
+		}
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/margin/myTrades",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var trades []*Margin_Trade
+	processingErr := json.Unmarshal(resp.Body, &trades)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return trades, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Query Cross Margin Account Details
+//
+// Weight: 10
+func (margin *Margin) CrossAccount(recvWindow ...int64) (*Margin_CrossAccount, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/margin/account",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var account *Margin_CrossAccount
+	processingErr := json.Unmarshal(resp.Body, &account)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return account, resp, nil
+}
+
+// # Query Isolated Margin Account Info
+//
+// # Up to 5 symbols can be sent, all the isolated pairs are returned if omitted
+//
+// Weight: 10
+func (margin *Margin) IsolatedAccount(symbols ...string) (*Margin_IsolatedAccount, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(symbols) != 0 {
+		opts["symbols"] = strings.Join(symbols, ",")
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/margin/isolated/account",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var account *Margin_IsolatedAccount
+	processingErr := json.Unmarshal(resp.Body, &account)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return account, resp, nil
+}
+
+// # Query Max Borrow
+//
+// "isolatedSymbol" is only sent for isolated margin
+//
+// Weight: 50
+func (margin *Margin) MaxBorrowable(asset string, isolatedSymbol ...string) (*Margin_MaxBorrowable, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["asset"] = asset
+	if len(isolatedSymbol) != 0 {
+		opts["isolatedSymbol"] = isolatedSymbol[0]
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/margin/maxBorrowable",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var maxBorrowable *Margin_MaxBorrowable
+	processingErr := json.Unmarshal(resp.Body, &maxBorrowable)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return maxBorrowable, resp, nil
+}
+
+// # Query Max Transfer-Out Amount
+//
+// "isolatedSymbol" is only sent for isolated margin
+//
+// Weight: 50
+func (margin *Margin) MaxTransferable(asset string, isolatedSymbol ...string) (*Margin_MaxTransferable, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["asset"] = asset
+	if len(isolatedSymbol) != 0 {
+		opts["isolatedSymbol"] = isolatedSymbol[0]
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/margin/maxTransferable",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var maxTransferable *Margin_MaxTransferable
+	processingErr := json.Unmarshal(resp.Body, &maxTransferable)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return maxTransferable, resp, nil
+}
+
+type Margin_InterestHistory_Params struct {
+	Asset          string
+	IsolatedSymbol string
+	StartTime      int64
+	EndTime        int64
+	// Currently querying page, starts from 1, default 1
+	Current int64
+	// Default 10, max 100
+	Size       int64
+	RecvWindow int64
+}
+
+// # Get Interest History
+//
+// Weight: 1
+func (margin *Margin) InterestHistory(opt_params ...Margin_InterestHistory_Params) (*Margin_InterestHistory, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Asset) {
+			opts["asset"] = params.Asset
+		}
+		if IsDifferentFromDefault(params.IsolatedSymbol) {
+			opts["isolatedSymbol"] = params.IsolatedSymbol
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.Current) {
+			opts["current"] = params.Current
+		}
+		if IsDifferentFromDefault(params.Size) {
+			opts["size"] = params.Size
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/margin/interestHistory",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var history *Margin_InterestHistory
+	processingErr := json.Unmarshal(resp.Body, &history)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return history, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+type Margin_Transfer_Params struct {
+	// Required when transferring out of an isolated margin account
+	FromSymbol string
+	// Required when transferring into an isolated margin account
+	ToSymbol   string
+	RecvWindow int64
+}
+
+// # Transfer between the spot and margin accounts
+//
+// "transferType" is one of MARGIN_Constants.TransferTypes, transfers go through the universal transfer endpoint
+//
+// Weight: 900
+func (margin *Margin) Transfer(asset string, amount string, transferType string, opt_params ...Margin_Transfer_Params) (*Margin_Transaction, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["type"] = transferType
+	opts["asset"] = asset
+	opts["amount"] = amount
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.FromSymbol) {
+			opts["fromSymbol"] = params.FromSymbol
+		}
+		if IsDifferentFromDefault(params.ToSymbol) {
+			opts["toSymbol"] = params.ToSymbol
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/asset/transfer",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var transaction *Margin_Transaction
+	processingErr := json.Unmarshal(resp.Body, &transaction)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return transaction, resp, nil
+}
+
+type Margin_TransferHistory_Params struct {
+	Asset string
+	// "ROLL_IN" | "ROLL_OUT"
+	Type           string
+	IsolatedSymbol string
+	StartTime      int64
+	EndTime        int64
+	// Currently querying page, starts from 1, default 1
+	Current int64
+	// Default 10, max 100
+	Size       int64
+	RecvWindow int64
+}
+
+// # Get Cross Margin Transfer History
+//
+// Weight: 1
+func (margin *Margin) TransferHistory(opt_params ...Margin_TransferHistory_Params) (*Margin_TransferHistory, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Asset) {
+			opts["asset"] = params.Asset
+		}
+		if IsDifferentFromDefault(params.Type) {
+			opts["type"] = params.Type
+		}
+		if IsDifferentFromDefault(params.IsolatedSymbol) {
+			opts["isolatedSymbol"] = params.IsolatedSymbol
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.Current) {
+			opts["current"] = params.Current
+		}
+		if IsDifferentFromDefault(params.Size) {
+			opts["size"] = params.Size
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/margin/transfer",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var history *Margin_TransferHistory
+	processingErr := json.Unmarshal(resp.Body, &history)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return history, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+func marginListenKeyURL(isolatedSymbol string) string {
+	if isolatedSymbol != "" {
+		return "/sapi/v1/userDataStream/isolated"
+	}
+	return "/sapi/v1/userDataStream"
+}
+
+// # Start Margin User Data Stream
+//
+// "isolatedSymbol" is only sent for an isolated margin account's stream
+//
+// Weight: 1
+func (margin *Margin) CreateListenKey(isolatedSymbol ...string) (*Margin_ListenKey, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	symbol := ""
+	if len(isolatedSymbol) != 0 {
+		symbol = isolatedSymbol[0]
+		opts["symbol"] = symbol
+	}
+
+	resp, err := margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_STREAM,
+		method:       Constants.Methods.POST,
+		url:          marginListenKeyURL(symbol),
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var listenKey *Margin_ListenKey
+	processingErr := json.Unmarshal(resp.Body, &listenKey)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return listenKey, resp, nil
+}
+
+// # Keepalive Margin User Data Stream
+//
+// Weight: 1
+func (margin *Margin) KeepAliveListenKey(listenKey string, isolatedSymbol ...string) (*Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["listenKey"] = listenKey
+	symbol := ""
+	if len(isolatedSymbol) != 0 {
+		symbol = isolatedSymbol[0]
+		opts["symbol"] = symbol
+	}
+
+	return margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_STREAM,
+		method:       Constants.Methods.PUT,
+		url:          marginListenKeyURL(symbol),
+		params:       opts,
+	})
+}
+
+// # Close Margin User Data Stream
+//
+// Weight: 1
+func (margin *Margin) CloseListenKey(listenKey string, isolatedSymbol ...string) (*Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["listenKey"] = listenKey
+	symbol := ""
+	if len(isolatedSymbol) != 0 {
+		symbol = isolatedSymbol[0]
+		opts["symbol"] = symbol
+	}
+
+	return margin.makeRequest(&MarginRequest{
+		securityType: MARGIN_Constants.SecurityTypes.USER_STREAM,
+		method:       Constants.Methods.DELETE,
+		url:          marginListenKeyURL(symbol),
+		params:       opts,
+	})
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+type MarginRequest struct {
+	method       string
+	url          string
+	params       map[string]interface{}
+	securityType string
+}
+
+func (margin *Margin) makeRequest(request *MarginRequest) (*Response, *Error) {
+
+	switch request.securityType {
+	case MARGIN_Constants.SecurityTypes.NONE:
+		return margin.requestClient.Unsigned(request.method, margin.baseURL, request.url, request.params)
+	case MARGIN_Constants.SecurityTypes.USER_STREAM:
+		return margin.requestClient.APIKEY_only(request.method, margin.baseURL, request.url, request.params)
+
+	case MARGIN_Constants.SecurityTypes.MARGIN:
+		return margin.requestClient.Signed(request.method, margin.baseURL, request.url, request.params)
+	case MARGIN_Constants.SecurityTypes.TRADE:
+		return margin.requestClient.Signed(request.method, margin.baseURL, request.url, request.params)
+	case MARGIN_Constants.SecurityTypes.USER_DATA:
+		return margin.requestClient.Signed(request.method, margin.baseURL, request.url, request.params)
+
+	default:
+		panic(fmt.Sprintf("Security Type passed to Request function is invalid, received: '%s'\nSupported methods are ('%s', '%s', '%s', '%s', '%s')", request.securityType, MARGIN_Constants.SecurityTypes.NONE, MARGIN_Constants.SecurityTypes.USER_STREAM, MARGIN_Constants.SecurityTypes.MARGIN, MARGIN_Constants.SecurityTypes.TRADE, MARGIN_Constants.SecurityTypes.USER_DATA))
+	}
+
+}
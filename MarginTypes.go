@@ -0,0 +1,323 @@
+package Binance
+
+var MARGIN_Constants = struct {
+	URLs               [1]string
+	SecurityTypes      Margin_SecurityTypes_ENUM
+	SideEffectTypes    Margin_SideEffectTypes_ENUM
+	BorrowRepayTypes   Margin_BorrowRepayTypes_ENUM
+	BorrowRepayStatus  Margin_BorrowRepayStatus_ENUM
+	TransferTypes      Margin_TransferTypes_ENUM
+	UserDataEventTypes Margin_UserDataEventTypes_ENUM
+
+	Websocket Margin_Websocket_Constants
+}{
+	URLs: [1]string{"https://api.binance.com"},
+	SecurityTypes: Margin_SecurityTypes_ENUM{
+		NONE:        "NONE",
+		USER_STREAM: "USER_STREAM",
+		MARGIN:      "MARGIN",
+		TRADE:       "TRADE",
+		USER_DATA:   "USER_DATA",
+	},
+	SideEffectTypes: Margin_SideEffectTypes_ENUM{
+		NO_SIDE_EFFECT:    "NO_SIDE_EFFECT",
+		MARGIN_BUY:        "MARGIN_BUY",
+		AUTO_REPAY:        "AUTO_REPAY",
+		AUTO_BORROW_REPAY: "AUTO_BORROW_REPAY",
+	},
+	BorrowRepayTypes: Margin_BorrowRepayTypes_ENUM{
+		BORROW: "BORROW",
+		REPAY:  "REPAY",
+	},
+	BorrowRepayStatus: Margin_BorrowRepayStatus_ENUM{
+		PENDING:   "PENDING",
+		CONFIRMED: "CONFIRMED",
+		FAILED:    "FAILED",
+	},
+	TransferTypes: Margin_TransferTypes_ENUM{
+		MAIN_MARGIN:                   "MAIN_MARGIN",
+		MARGIN_MAIN:                   "MARGIN_MAIN",
+		ISOLATEDMARGIN_MARGIN:         "ISOLATEDMARGIN_MARGIN",
+		MARGIN_ISOLATEDMARGIN:         "MARGIN_ISOLATEDMARGIN",
+		ISOLATEDMARGIN_ISOLATEDMARGIN: "ISOLATEDMARGIN_ISOLATEDMARGIN",
+	},
+	UserDataEventTypes: Margin_UserDataEventTypes_ENUM{
+		LISTEN_KEY_EXPIRED:        "listenKeyExpired",
+		OUTBOUND_ACCOUNT_POSITION: "outboundAccountPosition",
+		BALANCE_UPDATE:            "balanceUpdate",
+		EXECUTION_REPORT:          "executionReport",
+		LIST_STATUS:               "listStatus",
+	},
+	Websocket: Margin_Websocket_Constants{
+		LISTENKEY_KEEPALIVE_INTERVAL_SEC: (30 * MINUTE) / SECOND,
+	},
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////// Declarations
+//////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////// Definitions
+
+type Margin_SecurityTypes_ENUM struct {
+	NONE        string
+	USER_STREAM string
+	MARGIN      string
+	TRADE       string
+	USER_DATA   string
+}
+
+type Margin_SideEffectTypes_ENUM struct {
+	NO_SIDE_EFFECT    string
+	MARGIN_BUY        string
+	AUTO_REPAY        string
+	AUTO_BORROW_REPAY string
+}
+
+type Margin_BorrowRepayTypes_ENUM struct {
+	BORROW string
+	REPAY  string
+}
+
+type Margin_BorrowRepayStatus_ENUM struct {
+	PENDING   string
+	CONFIRMED string
+	FAILED    string
+}
+
+type Margin_TransferTypes_ENUM struct {
+	// Spot to cross margin
+	MAIN_MARGIN string
+	// Cross margin to spot
+	MARGIN_MAIN string
+	// Isolated margin to cross margin, requires "FromSymbol"
+	ISOLATEDMARGIN_MARGIN string
+	// Cross margin to isolated margin, requires "ToSymbol"
+	MARGIN_ISOLATEDMARGIN string
+	// Isolated margin to isolated margin, requires both "FromSymbol" and "ToSymbol"
+	ISOLATEDMARGIN_ISOLATEDMARGIN string
+}
+
+type Margin_UserDataEventTypes_ENUM struct {
+	LISTEN_KEY_EXPIRED        string
+	OUTBOUND_ACCOUNT_POSITION string
+	BALANCE_UPDATE            string
+	EXECUTION_REPORT          string
+	LIST_STATUS               string
+}
+
+// Margin user data streams are served on SPOT_Constants.Websocket.URLs
+type Margin_Websocket_Constants struct {
+	// A listenKey is valid for 60 minutes, it is kept alive every 30 minutes
+	LISTENKEY_KEEPALIVE_INTERVAL_SEC int64
+}
+
+//////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type Margin_Transaction struct {
+	TranId int64 `json:"tranId"`
+}
+
+type Margin_BorrowRepayHistory struct {
+	Rows  []*Margin_BorrowRepayRecord `json:"rows"`
+	Total int64                       `json:"total"`
+}
+
+type Margin_BorrowRepayRecord struct {
+	// Only set for isolated margin records
+	IsolatedSymbol string `json:"isolatedSymbol"`
+	Amount         string `json:"amount"`
+	Asset          string `json:"asset"`
+	Interest       string `json:"interest"`
+	Principal      string `json:"principal"`
+	// "PENDING" | "CONFIRMED" | "FAILED"
+	Status    string `json:"status"`
+	Timestamp int64  `json:"timestamp"`
+	TxId      int64  `json:"txId"`
+}
+
+type Margin_Order struct {
+	Symbol                  string  `json:"symbol"`
+	IsIsolated              bool    `json:"isIsolated"`
+	OrderId                 int64   `json:"orderId"`
+	OrderListId             int64   `json:"orderListId"`
+	ClientOrderId           string  `json:"clientOrderId"`
+	TransactTime            int64   `json:"transactTime"`
+	Price                   Decimal `json:"price"`
+	OrigQty                 Decimal `json:"origQty"`
+	ExecutedQty             Decimal `json:"executedQty"`
+	CummulativeQuoteQty     Decimal `json:"cummulativeQuoteQty"`
+	Status                  string  `json:"status"`
+	TimeInForce             string  `json:"timeInForce"`
+	Type                    string  `json:"type"`
+	Side                    string  `json:"side"`
+	StopPrice               Decimal `json:"stopPrice"`
+	IcebergQty              Decimal `json:"icebergQty"`
+	IsWorking               bool    `json:"isWorking"`
+	Time                    int64   `json:"time"`
+	UpdateTime              int64   `json:"updateTime"`
+	SelfTradePreventionMode string  `json:"selfTradePreventionMode"`
+	// Only set on new orders that triggered a borrow
+	MarginBuyBorrowAmount string              `json:"marginBuyBorrowAmount"`
+	MarginBuyBorrowAsset  string              `json:"marginBuyBorrowAsset"`
+	Fills                 []*Spot_Order_Fills `json:"fills"`
+}
+
+type Margin_OCO struct {
+	OrderListId       int64  `json:"orderListId"`
+	ContingencyType   string `json:"contingencyType"`
+	ListStatusType    string `json:"listStatusType"`
+	ListOrderStatus   string `json:"listOrderStatus"`
+	ListClientOrderId string `json:"listClientOrderId"`
+	TransactionTime   int64  `json:"transactionTime"`
+	Symbol            string `json:"symbol"`
+	IsIsolated        bool   `json:"isIsolated"`
+	// Only set on new OCOs that triggered a borrow
+	MarginBuyBorrowAmount string                        `json:"marginBuyBorrowAmount"`
+	MarginBuyBorrowAsset  string                        `json:"marginBuyBorrowAsset"`
+	Orders                []*Margin_OCO_OrderIdentifier `json:"orders"`
+	// Only set on placement and cancellation
+	OrderReports []*Margin_Order `json:"orderReports"`
+}
+
+type Margin_OCO_OrderIdentifier struct {
+	Symbol        string `json:"symbol"`
+	OrderId       int64  `json:"orderId"`
+	ClientOrderId string `json:"clientOrderId"`
+}
+
+type Margin_Trade struct {
+	Id              int64   `json:"id"`
+	Symbol          string  `json:"symbol"`
+	OrderId         int64   `json:"orderId"`
+	Price           Decimal `json:"price"`
+	Qty             Decimal `json:"qty"`
+	Commission      string  `json:"commission"`
+	CommissionAsset string  `json:"commissionAsset"`
+	Time            int64   `json:"time"`
+	IsBuyer         bool    `json:"isBuyer"`
+	IsMaker         bool    `json:"isMaker"`
+	IsBestMatch     bool    `json:"isBestMatch"`
+	IsIsolated      bool    `json:"isIsolated"`
+}
+
+//////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type Margin_CrossAccount struct {
+	Created                    bool   `json:"created"`
+	BorrowEnabled              bool   `json:"borrowEnabled"`
+	TradeEnabled               bool   `json:"tradeEnabled"`
+	TransferInEnabled          bool   `json:"transferInEnabled"`
+	TransferOutEnabled         bool   `json:"transferOutEnabled"`
+	MarginLevel                string `json:"marginLevel"`
+	CollateralMarginLevel      string `json:"collateralMarginLevel"`
+	TotalAssetOfBtc            string `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc        string `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc         string `json:"totalNetAssetOfBtc"`
+	TotalCollateralValueInUSDT string `json:"TotalCollateralValueInUSDT"`
+	TotalOpenOrderLossInUSDT   string `json:"totalOpenOrderLossInUSDT"`
+	// "MARGIN_1" for Cross Margin Classic, "MARGIN_2" for Cross Margin Pro
+	AccountType string                       `json:"accountType"`
+	UserAssets  []*Margin_CrossAccount_Asset `json:"userAssets"`
+}
+
+type Margin_CrossAccount_Asset struct {
+	Asset    string `json:"asset"`
+	Borrowed string `json:"borrowed"`
+	Free     string `json:"free"`
+	Interest string `json:"interest"`
+	Locked   string `json:"locked"`
+	NetAsset string `json:"netAsset"`
+}
+
+type Margin_IsolatedAccount struct {
+	Assets []*Margin_IsolatedAccount_Pair `json:"assets"`
+	// Only set when no symbols were specified
+	TotalAssetOfBtc     string `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc string `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc  string `json:"totalNetAssetOfBtc"`
+}
+
+type Margin_IsolatedAccount_Pair struct {
+	Symbol          string                        `json:"symbol"`
+	BaseAsset       *Margin_IsolatedAccount_Asset `json:"baseAsset"`
+	QuoteAsset      *Margin_IsolatedAccount_Asset `json:"quoteAsset"`
+	IsolatedCreated bool                          `json:"isolatedCreated"`
+	// True if the account is enabled, false if it has been disabled
+	Enabled     bool   `json:"enabled"`
+	MarginLevel string `json:"marginLevel"`
+	// "EXCESSIVE", "NORMAL", "MARGIN_CALL", "PRE_LIQUIDATION", "FORCE_LIQUIDATION"
+	MarginLevelStatus string  `json:"marginLevelStatus"`
+	MarginRatio       string  `json:"marginRatio"`
+	IndexPrice        Decimal `json:"indexPrice"`
+	LiquidatePrice    Decimal `json:"liquidatePrice"`
+	LiquidateRate     string  `json:"liquidateRate"`
+	TradeEnabled      bool    `json:"tradeEnabled"`
+}
+
+type Margin_IsolatedAccount_Asset struct {
+	Asset         string `json:"asset"`
+	BorrowEnabled bool   `json:"borrowEnabled"`
+	Borrowed      string `json:"borrowed"`
+	Free          string `json:"free"`
+	Interest      string `json:"interest"`
+	Locked        string `json:"locked"`
+	NetAsset      string `json:"netAsset"`
+	NetAssetOfBtc string `json:"netAssetOfBtc"`
+	RepayEnabled  bool   `json:"repayEnabled"`
+	TotalAsset    string `json:"totalAsset"`
+}
+
+type Margin_MaxBorrowable struct {
+	// Account's currently max borrowable amount with sufficient system availability
+	Amount string `json:"amount"`
+	// Max borrowable amount limited by the account level
+	BorrowLimit string `json:"borrowLimit"`
+}
+
+type Margin_MaxTransferable struct {
+	Amount string `json:"amount"`
+}
+
+type Margin_InterestHistory struct {
+	Rows  []*Margin_InterestRecord `json:"rows"`
+	Total int64                    `json:"total"`
+}
+
+type Margin_InterestRecord struct {
+	TxId                int64  `json:"txId"`
+	InterestAccuredTime int64  `json:"interestAccuredTime"`
+	Asset               string `json:"asset"`
+	// Only set for isolated margin records, the underlying asset of the isolated pair
+	RawAsset     string `json:"rawAsset"`
+	Principal    string `json:"principal"`
+	Interest     string `json:"interest"`
+	InterestRate string `json:"interestRate"`
+	// "PERIODIC" | "ON_BORROW" | "PERIODIC_CONVERTED" | "ON_BORROW_CONVERTED" | "PORTFOLIO"
+	Type string `json:"type"`
+	// Only set for isolated margin records
+	IsolatedSymbol string `json:"isolatedSymbol"`
+}
+
+type Margin_TransferHistory struct {
+	Rows  []*Margin_TransferRecord `json:"rows"`
+	Total int64                    `json:"total"`
+}
+
+type Margin_TransferRecord struct {
+	Amount string `json:"amount"`
+	Asset  string `json:"asset"`
+	// "PENDING" | "CONFIRMED" | "FAILED"
+	Status    string `json:"status"`
+	Timestamp int64  `json:"timestamp"`
+	TxId      int64  `json:"txId"`
+	// "ROLL_IN" | "ROLL_OUT"
+	Type string `json:"type"`
+	// "SPOT" | "ISOLATED_MARGIN" | "CROSS_MARGIN"
+	TransFrom  string `json:"transFrom"`
+	TransTo    string `json:"transTo"`
+	FromSymbol string `json:"fromSymbol"`
+	ToSymbol   string `json:"toSymbol"`
+}
+
+type Margin_ListenKey struct {
+	ListenKey string `json:"listenKey"`
+}
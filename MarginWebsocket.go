@@ -0,0 +1,505 @@
+package Binance
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Margin user data streams are served on the Spot websocket host, with the Spot payloads
+type Margin_Websockets struct {
+	binance *Binance
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type MarginWS_UserData_Event struct {
+
+	// Event type
+	Event string `json:"e"`
+}
+
+type MarginWS_ListenKeyExpired struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// The listenKey that has expired
+	ListenKey string `json:"listenKey"`
+}
+
+// Binance has sent the event time both as a number and as a string
+func (event *MarginWS_ListenKeyExpired) UnmarshalJSON(data []byte) error {
+	type Alias MarginWS_ListenKeyExpired
+	aux := &struct {
+		EventTime interface{} `json:"E"`
+		*Alias
+	}{
+		Alias: (*Alias)(event),
+	}
+
+	err := json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	switch eventTime := aux.EventTime.(type) {
+	case float64:
+		event.EventTime = int64(eventTime)
+	case string:
+		event.EventTime, _ = strconv.ParseInt(eventTime, 10, 64)
+	}
+
+	return nil
+}
+
+type MarginWS_OutboundAccountPosition struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// Time of last account update
+	LastUpdateTime int64 `json:"u"`
+
+	// Balances of the assets that changed
+	Balances []*MarginWS_OutboundAccountPosition_Balance `json:"B"`
+}
+
+type MarginWS_OutboundAccountPosition_Balance struct {
+
+	// Asset
+	Asset string `json:"a"`
+
+	// Free
+	Free string `json:"f"`
+
+	// Locked
+	Locked string `json:"l"`
+}
+
+type MarginWS_BalanceUpdate struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// Asset
+	Asset string `json:"a"`
+
+	// Balance delta
+	BalanceDelta string `json:"d"`
+
+	// Clear time
+	ClearTime int64 `json:"T"`
+}
+
+type MarginWS_ExecutionReport struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// Symbol
+	Symbol string `json:"s"`
+
+	// Client order ID
+	ClientOrderId string `json:"c"`
+
+	// Side
+	Side string `json:"S"`
+
+	// Order type
+	OrderType string `json:"o"`
+
+	// Time in force
+	TimeInForce string `json:"f"`
+
+	// Order quantity
+	Quantity string `json:"q"`
+
+	// Order price
+	Price string `json:"p"`
+
+	// Stop price
+	StopPrice string `json:"P"`
+
+	// Iceberg quantity
+	IcebergQty string `json:"F"`
+
+	// OrderListId
+	OrderListId int64 `json:"g"`
+
+	// Original client order ID; This is the ID of the order being canceled
+	OrigClientOrderId string `json:"C"`
+
+	// Current execution type
+	ExecutionType string `json:"x"`
+
+	// Current order status
+	OrderStatus string `json:"X"`
+
+	// Order reject reason; will be an error code.
+	RejectReason string `json:"r"`
+
+	// Order ID
+	OrderId int64 `json:"i"`
+
+	// Last executed quantity
+	LastFilledQty string `json:"l"`
+
+	// Cumulative filled quantity
+	FilledAccumulatedQty string `json:"z"`
+
+	// Last executed price
+	LastFilledPrice string `json:"L"`
+
+	// Commission amount
+	Commission string `json:"n"`
+
+	// Commission asset
+	CommissionAsset string `json:"N"`
+
+	// Transaction time
+	TransactionTime int64 `json:"T"`
+
+	// Trade ID
+	TradeId int64 `json:"t"`
+
+	// Prevented Match Id; This is only visible if the order expired due to STP
+	PreventedMatchId int64 `json:"v"`
+
+	// Execution Id
+	ExecutionId int64 `json:"I"`
+
+	// Is the order on the book?
+	IsOnBook bool `json:"w"`
+
+	// Is this trade the maker side?
+	IsMaker bool `json:"m"`
+
+	// Ignore
+	Ignore bool `json:"M"`
+
+	// Order creation time
+	CreationTime int64 `json:"O"`
+
+	// Cumulative quote asset transacted quantity
+	CumulativeQuoteQty string `json:"Z"`
+
+	// Last quote asset transacted quantity (i.e. lastPrice * lastQty)
+	LastQuoteQty string `json:"Y"`
+
+	// Quote Order Quantity
+	QuoteOrderQty string `json:"Q"`
+
+	// Working Time; This is only visible if the order has been placed on the book.
+	WorkingTime int64 `json:"W"`
+
+	// SelfTradePreventionMode
+	SelfTradePreventionMode string `json:"V"`
+}
+
+type MarginWS_ListStatus struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// Symbol
+	Symbol string `json:"s"`
+
+	// OrderListId
+	OrderListId int64 `json:"g"`
+
+	// Contingency type
+	ContingencyType string `json:"c"`
+
+	// List status type
+	ListStatusType string `json:"l"`
+
+	// List order status
+	ListOrderStatus string `json:"L"`
+
+	// List reject reason
+	ListRejectReason string `json:"r"`
+
+	// List client order ID
+	ListClientOrderId string `json:"C"`
+
+	// Transaction time
+	TransactionTime int64 `json:"T"`
+
+	// The orders of the list
+	Orders []*MarginWS_ListStatus_Order `json:"O"`
+}
+
+type MarginWS_ListStatus_Order struct {
+
+	// Symbol
+	Symbol string `json:"s"`
+
+	// Order ID
+	OrderId int64 `json:"i"`
+
+	// Client order ID
+	ClientOrderId string `json:"c"`
+}
+
+// Every handler is optional, events without a handler are ignored
+type MarginWS_UserData_Handlers struct {
+	OnOutboundAccountPosition func(accountPosition *MarginWS_OutboundAccountPosition)
+	OnBalanceUpdate           func(balanceUpdate *MarginWS_BalanceUpdate)
+	OnExecutionReport         func(executionReport *MarginWS_ExecutionReport)
+	OnListStatus              func(listStatus *MarginWS_ListStatus)
+
+	// Called after the listenKey has expired
+	// The socket renews its listenKey and reconnects on its own
+	OnListenKeyExpired func(listenKeyExpired *MarginWS_ListenKeyExpired)
+
+	// Called for any event type this library doesn't decode yet
+	OnUnknownEvent func(eventType string, msg []byte)
+}
+
+type MarginWS_UserData_Socket struct {
+	Handler  *Spot_Websocket
+	Handlers *MarginWS_UserData_Handlers
+
+	margin *Margin
+	// Empty for the cross margin account
+	isolatedSymbol string
+
+	mu            sync.Mutex
+	listenKey     string
+	stopKeepAlive chan struct{}
+}
+
+func (socket *MarginWS_UserData_Socket) ListenKey() string {
+	socket.mu.Lock()
+	defer socket.mu.Unlock()
+
+	return socket.listenKey
+}
+
+func (socket *MarginWS_UserData_Socket) isolatedSymbolParam() []string {
+	if socket.isolatedSymbol == "" {
+		return nil
+	}
+	return []string{socket.isolatedSymbol}
+}
+
+// Stops the keepalive, closes the listenKey and closes the socket indefinitely
+func (socket *MarginWS_UserData_Socket) Close() error {
+	socket.mu.Lock()
+	if socket.stopKeepAlive != nil {
+		close(socket.stopKeepAlive)
+		socket.stopKeepAlive = nil
+	}
+	socket.mu.Unlock()
+
+	_, err := socket.margin.CloseListenKey(socket.ListenKey(), socket.isolatedSymbolParam()...)
+	if err != nil {
+		LOG_WS_ERRORS("[MARGIN USERDATA] There was an error closing the listenKey:", err.Error())
+	}
+
+	return socket.Handler.Close()
+}
+
+// Creates (or retrieves) the account's listenKey and points the socket's stream to it
+func (socket *MarginWS_UserData_Socket) renewListenKey() *Error {
+	listenKey, _, err := socket.margin.CreateListenKey(socket.isolatedSymbolParam()...)
+	if err != nil {
+		return err
+	}
+
+	socket.mu.Lock()
+	socket.listenKey = listenKey.ListenKey
+	socket.mu.Unlock()
+
+	if socket.Handler != nil {
+		socket.Handler.Websocket.setStreams([]string{listenKey.ListenKey})
+	}
+
+	return nil
+}
+
+func (socket *MarginWS_UserData_Socket) keepAlive(stop chan struct{}) {
+	ticker := time.NewTicker(time.Duration(MARGIN_Constants.Websocket.LISTENKEY_KEEPALIVE_INTERVAL_SEC) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		// Closed without Close(), i.e: with Handler.Close() or after giving up reconnecting
+		case <-socket.Handler.Websocket.closing:
+			return
+		case <-ticker.C:
+		}
+
+		_, err := socket.margin.KeepAliveListenKey(socket.ListenKey(), socket.isolatedSymbolParam()...)
+		if err == nil {
+			continue
+		}
+		LOG_WS_ERRORS("[MARGIN USERDATA] There was an error keeping the listenKey alive:", err.Error())
+
+		// -1125: This listenKey does not exist.
+		if err.IsLocalError || err.Code != -1125 {
+			continue
+		}
+
+		LOG_WS_VERBOSE("[MARGIN USERDATA] listenKey is no longer valid, reconnecting with a new one...")
+		socket.Handler.Reconnect()
+	}
+}
+
+func (socket *MarginWS_UserData_Socket) handleMessage(stream string, msg []byte) {
+	var event MarginWS_UserData_Event
+	err := json.Unmarshal(msg, &event)
+	if err != nil {
+		socket.Handler.Websocket.decodeError(stream, msg, err)
+		return
+	}
+
+	handlers := socket.Handlers
+	eventTypes := MARGIN_Constants.UserDataEventTypes
+
+	switch event.Event {
+	case eventTypes.LISTEN_KEY_EXPIRED:
+		var listenKeyExpired MarginWS_ListenKeyExpired
+		err := json.Unmarshal(msg, &listenKeyExpired)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		if handlers.OnListenKeyExpired != nil {
+			handlers.OnListenKeyExpired(&listenKeyExpired)
+		}
+
+		// The read loop must not be blocked by the reconnection
+		go socket.Handler.Reconnect()
+
+	case eventTypes.OUTBOUND_ACCOUNT_POSITION:
+		if handlers.OnOutboundAccountPosition == nil {
+			return
+		}
+		var accountPosition MarginWS_OutboundAccountPosition
+		err := json.Unmarshal(msg, &accountPosition)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		handlers.OnOutboundAccountPosition(&accountPosition)
+
+	case eventTypes.BALANCE_UPDATE:
+		if handlers.OnBalanceUpdate == nil {
+			return
+		}
+		var balanceUpdate MarginWS_BalanceUpdate
+		err := json.Unmarshal(msg, &balanceUpdate)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		handlers.OnBalanceUpdate(&balanceUpdate)
+
+	case eventTypes.EXECUTION_REPORT:
+		if handlers.OnExecutionReport == nil {
+			return
+		}
+		var executionReport MarginWS_ExecutionReport
+		err := json.Unmarshal(msg, &executionReport)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		handlers.OnExecutionReport(&executionReport)
+
+	case eventTypes.LIST_STATUS:
+		if handlers.OnListStatus == nil {
+			return
+		}
+		var listStatus MarginWS_ListStatus
+		err := json.Unmarshal(msg, &listStatus)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		handlers.OnListStatus(&listStatus)
+
+	default:
+		if handlers.OnUnknownEvent != nil {
+			handlers.OnUnknownEvent(event.Event, msg)
+		}
+	}
+}
+
+// # Margin User Data Streams
+//
+// Opens the cross margin account's user data stream, or the isolated margin account's if "isolatedSymbol" is sent.
+//
+// The listenKey is created, kept alive every 30 minutes, and renewed on expiry (the socket reconnects with the new listenKey on its own).
+//
+// Calling 'Close()' on the returned socket also closes the listenKey.
+func (margin_ws *Margin_Websockets) UserData(handlers *MarginWS_UserData_Handlers, isolatedSymbol ...string) (*MarginWS_UserData_Socket, *Error) {
+	if handlers == nil {
+		handlers = &MarginWS_UserData_Handlers{}
+	}
+
+	newSocket := &MarginWS_UserData_Socket{
+		Handlers: handlers,
+		margin:   &margin_ws.binance.Margin,
+	}
+	if len(isolatedSymbol) != 0 {
+		newSocket.isolatedSymbol = isolatedSymbol[0]
+	}
+
+	err := newSocket.renewListenKey()
+	if err != nil {
+		return nil, err
+	}
+
+	socket, err := margin_ws.CreateSocket([]string{newSocket.ListenKey()}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		newSocket.handleMessage(stream, msg)
+	})
+	socket.Websocket.setBeforeReconnect(func() {
+		err := newSocket.renewListenKey()
+		if err != nil {
+			LOG_WS_ERRORS("[MARGIN USERDATA] There was an error renewing the listenKey:", err.Error())
+		}
+	})
+
+	newSocket.Handler = socket
+
+	stop := make(chan struct{})
+	newSocket.stopKeepAlive = stop
+	socket.Websocket.goroutine(func() { newSocket.keepAlive(stop) })
+
+	return newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (margin_ws *Margin_Websockets) CreateSocket(streams []string, isCombined bool) (*Spot_Websocket, *Error) {
+	return margin_ws.binance.Spot.Websockets.CreateSocket(streams, isCombined)
+}
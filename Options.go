@@ -0,0 +1,595 @@
+package Binance
+
+import (
+	"fmt"
+	"time"
+)
+
+// # European Options
+//
+// Option symbols can be parsed with ParseOptionSymbol.
+type Options struct {
+	binance       *Binance
+	requestClient RequestClient
+	baseURL       string
+
+	API APIKEYS
+
+	Websockets Options_Websockets
+}
+
+func (options *Options) init(binance *Binance) {
+	options.binance = binance
+
+	options.requestClient.init(binance)
+	options.requestClient.Set_APIKEY(binance.API.KEY, binance.API.SECRET)
+
+	options.baseURL = OPTIONS_Constants.URLs[0]
+
+	options.API.Set(binance.API.KEY, binance.API.SECRET)
+
+	options.Websockets.binance = binance
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Test connectivity to the Rest API.
+//
+// Weight: 1
+func (options *Options) Ping() (latency int64, request *Response, err *Error) {
+	startTime := time.Now().UnixMilli()
+	httpResp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/eapi/v1/ping",
+	})
+	diff := time.Now().UnixMilli() - startTime
+	if err != nil {
+		return diff, httpResp, err
+	}
+
+	return diff, httpResp, nil
+}
+
+func (options *Options) ServerTime() (*Options_Time, *Response, *Error) {
+	var optionsTime Options_Time
+
+	startTime := time.Now().UnixMilli()
+	httpResp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/eapi/v1/time",
+	})
+	diff := time.Now().UnixMilli() - startTime
+	optionsTime.Latency = diff
+	if err != nil {
+		return &optionsTime, httpResp, err
+	}
+
+	processingErr := json.Unmarshal(httpResp.Body, &optionsTime)
+	if processingErr != nil {
+		return &optionsTime, httpResp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+
+	return &optionsTime, httpResp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+//////// ExchangeInfo \\
+
+func (options *Options) ExchangeInfo() (*Options_ExchangeInfo, *Response, *Error) {
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/eapi/v1/exchangeInfo",
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	exchangeInfo, err := ParseOptionsExchangeInfo(resp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return exchangeInfo, resp, nil
+}
+
+func ParseOptionsExchangeInfo(exchangeInfo_response *Response) (*Options_ExchangeInfo, *Error) {
+	var exchangeInfo Options_ExchangeInfo
+
+	err := json.Unmarshal(exchangeInfo_response.Body, &exchangeInfo)
+	if err != nil {
+		return nil, LocalError(PARSING_ERR, err.Error())
+	}
+
+	exchangeInfo.Symbols.Map = make(map[string]*Options_Symbol)
+	for _, symbol_obj := range exchangeInfo.Symbols_arr {
+		exchangeInfo.Symbols.Map[symbol_obj.Symbol] = symbol_obj
+	}
+
+	return &exchangeInfo, nil
+}
+
+//////// ExchangeInfo //
+/////////////////////////////////////////////////////////////////////////////////
+
+func (options *Options) OrderBook(symbol string, limit ...int64) (*Options_OrderBook, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if len(limit) != 0 {
+		opts["limit"] = limit[0]
+	}
+
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/eapi/v1/depth",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var orderBook Options_OrderBook
+
+	unmarshallErr := json.Unmarshal(resp.Body, &orderBook)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return &orderBook, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Options_Candlesticks_Params struct {
+	StartTime int64
+	EndTime   int64
+	// Default 500; max 1500.
+	Limit int64
+}
+
+// "interval" can be any of FUTURES_Constants.ChartIntervals
+func (options *Options) Candlesticks(symbol string, interval string, opt_params ...Options_Candlesticks_Params) ([]*Options_Candlestick, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	opts["interval"] = interval
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if params.Limit != 0 {
+			opts["limit"] = params.Limit
+		}
+		if params.StartTime != 0 {
+			opts["startTime"] = params.StartTime
+		}
+		if params.EndTime != 0 {
+			opts["endTime"] = params.EndTime
+		}
+	}
+
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/eapi/v1/klines",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var candlesticks []*Options_Candlestick
+	unmarshallErr := json.Unmarshal(resp.Body, &candlesticks)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return candlesticks, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Option Mark Price
+//
+// Option mark price and greek info.
+//
+// If the symbol is not sent, the mark prices of all symbols will be returned.
+//
+// Weight: 5
+func (options *Options) MarkPrice(symbol ...string) ([]*Options_MarkPrice, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(symbol) != 0 {
+		opts["symbol"] = symbol[0]
+	}
+
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/eapi/v1/mark",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var markPrices []*Options_MarkPrice
+	unmarshallErr := json.Unmarshal(resp.Body, &markPrices)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return markPrices, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Index Price
+//
+// Get spot index price for option underlying.
+//
+// "underlying" is the spot pair, i.e: "BTCUSDT"
+//
+// Weight: 1
+func (options *Options) IndexPrice(underlying string) (*Options_IndexPrice, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["underlying"] = underlying
+
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/eapi/v1/index",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var indexPrice *Options_IndexPrice
+	unmarshallErr := json.Unmarshal(resp.Body, &indexPrice)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return indexPrice, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Open Interest
+//
+// Get open interest for specific underlying asset on specific expiration date.
+//
+// "underlyingAsset" i.e: "BTC", "expiration" is formatted as "YYMMDD", i.e: "240329"
+//
+// Weight: 0
+func (options *Options) OpenInterest(underlyingAsset string, expiration string) ([]*Options_OpenInterest, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["underlyingAsset"] = underlyingAsset
+	opts["expiration"] = expiration
+
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/eapi/v1/openInterest",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var openInterests []*Options_OpenInterest
+	unmarshallErr := json.Unmarshal(resp.Body, &openInterests)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return openInterests, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Options_ExerciseHistory_Params struct {
+	// Underlying index like "BTCUSDT"
+	Underlying string
+	StartTime  int64
+	EndTime    int64
+	// Default 100; max 100.
+	Limit int64
+}
+
+// # Historical Exercise Records
+//
+// Get historical exercise records.
+//
+// Weight: 3
+func (options *Options) ExerciseHistory(opt_params ...Options_ExerciseHistory_Params) ([]*Options_ExerciseHistory, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Underlying) {
+			opts["underlying"] = params.Underlying
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+	}
+
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/eapi/v1/exerciseHistory",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var exerciseHistory []*Options_ExerciseHistory
+	unmarshallErr := json.Unmarshal(resp.Body, &exerciseHistory)
+	if unmarshallErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, unmarshallErr.Error())
+	}
+
+	return exerciseHistory, resp, nil
+}
+
+// //////////////////////////// Orders \\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\
+
+type Options_Order_Params struct {
+	// Defaults to "GTC"
+	TimeInForce      string
+	ReduceOnly       bool
+	PostOnly         bool
+	NewOrderRespType string
+	ClientOrderId    string
+	// is market maker protection order
+	IsMmp      bool
+	RecvWindow int64
+}
+
+// # New Order
+//
+// Only "LIMIT" orders are supported.
+//
+// Weight: 0
+func (options *Options) NewOrder(symbol string, side string, price string, quantity string, opt_params ...Options_Order_Params) (*Options_Order, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+	opts["side"] = side
+	opts["type"] = OPTIONS_Constants.OrderTypes.LIMIT
+	opts["price"] = price
+	opts["quantity"] = quantity
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.TimeInForce) {
+			opts["timeInForce"] = params.TimeInForce
+		}
+		if IsDifferentFromDefault(params.ReduceOnly) {
+			opts["reduceOnly"] = params.ReduceOnly
+		}
+		if IsDifferentFromDefault(params.PostOnly) {
+			opts["postOnly"] = params.PostOnly
+		}
+		if IsDifferentFromDefault(params.NewOrderRespType) {
+			opts["newOrderRespType"] = params.NewOrderRespType
+		}
+		if IsDifferentFromDefault(params.ClientOrderId) {
+			opts["clientOrderId"] = params.ClientOrderId
+		}
+		if IsDifferentFromDefault(params.IsMmp) {
+			opts["isMmp"] = params.IsMmp
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.TRADE,
+		method:       Constants.Methods.POST,
+		url:          "/eapi/v1/order",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var order *Options_Order
+	processingErr := json.Unmarshal(resp.Body, &order)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return order, resp, nil
+}
+
+type Options_CancelOrder_Params struct {
+	OrderId       int64
+	ClientOrderId string
+	RecvWindow    int64
+}
+
+// # Cancel Option Order
+//
+// Either "OrderId" or "ClientOrderId" must be sent.
+//
+// Weight: 1
+func (options *Options) CancelOrder(symbol string, params Options_CancelOrder_Params) (*Options_Order, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if IsDifferentFromDefault(params.OrderId) {
+		opts["orderId"] = params.OrderId
+	}
+	if IsDifferentFromDefault(params.ClientOrderId) {
+		opts["clientOrderId"] = params.ClientOrderId
+	}
+	if IsDifferentFromDefault(params.RecvWindow) {
+		opts["recvWindow"] = params.RecvWindow
+	}
+
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.TRADE,
+		method:       Constants.Methods.DELETE,
+		url:          "/eapi/v1/order",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var order *Options_Order
+	processingErr := json.Unmarshal(resp.Body, &order)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return order, resp, nil
+}
+
+// # Cancel all Option orders on specific symbol
+//
+// Weight: 1
+func (options *Options) CancelAllOrders(symbol string, recvWindow ...int64) (*Options_CancelAllOrders_Response, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["symbol"] = symbol
+
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.TRADE,
+		method:       Constants.Methods.DELETE,
+		url:          "/eapi/v1/allOpenOrders",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var response *Options_CancelAllOrders_Response
+	processingErr := json.Unmarshal(resp.Body, &response)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return response, resp, nil
+}
+
+// \\\\\\\\\\\\\\\\\\\\\\\\\\\ Orders ////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+type Options_Positions_Params struct {
+	Symbol     string
+	RecvWindow int64
+}
+
+// # Option Position Information
+//
+// Get current position information.
+//
+// Weight: 5
+func (options *Options) Positions(opt_params ...Options_Positions_Params) ([]*Options_Position, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Symbol) {
+			opts["symbol"] = params.Symbol
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/eapi/v1/position",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var positions []*Options_Position
+	processingErr := json.Unmarshal(resp.Body, &positions)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return positions, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Option Account Information
+//
+// Get current account information.
+//
+// Weight: 3
+func (options *Options) AccountInfo(recvWindow ...int64) (*Options_AccountInfo, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := options.makeRequest(&FuturesRequest{
+		securityType: FUTURES_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/eapi/v1/account",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var accountInfo *Options_AccountInfo
+	processingErr := json.Unmarshal(resp.Body, &accountInfo)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return accountInfo, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+func (options *Options) makeRequest(request *FuturesRequest) (*Response, *Error) {
+
+	switch request.securityType {
+	case FUTURES_Constants.SecurityTypes.NONE:
+		return options.requestClient.Unsigned(request.method, options.baseURL, request.url, request.params)
+	case FUTURES_Constants.SecurityTypes.MARKET_DATA:
+		return options.requestClient.APIKEY_only(request.method, options.baseURL, request.url, request.params)
+	case FUTURES_Constants.SecurityTypes.USER_STREAM:
+		return options.requestClient.APIKEY_only(request.method, options.baseURL, request.url, request.params)
+
+	case FUTURES_Constants.SecurityTypes.TRADE:
+		return options.requestClient.Signed(request.method, options.baseURL, request.url, request.params)
+	case FUTURES_Constants.SecurityTypes.USER_DATA:
+		return options.requestClient.Signed(request.method, options.baseURL, request.url, request.params)
+
+	default:
+		panic(fmt.Sprintf("Security Type passed to Request function is invalid, received: '%s'\nSupported methods are ('%s', '%s', '%s', '%s')", request.securityType, FUTURES_Constants.SecurityTypes.NONE, FUTURES_Constants.SecurityTypes.USER_STREAM, FUTURES_Constants.SecurityTypes.TRADE, FUTURES_Constants.SecurityTypes.USER_DATA))
+	}
+
+}
@@ -0,0 +1,380 @@
+package Binance
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// European Options share their security types and chart intervals with FUTURES_Constants
+var OPTIONS_Constants = struct {
+	URLs [1]string
+
+	OptionTypes      Options_OptionTypes_ENUM
+	OrderTypes       Options_OrderTypes_ENUM
+	OrderStatuses    Options_OrderStatuses_ENUM
+	TimeInForce      Options_TimeInForce_ENUM
+	StrikeResults    Options_StrikeResults_ENUM
+	SymbolFilterType Options_SymbolFilterTypes_ENUM
+
+	Websocket Options_Websocket_Constants
+}{
+	URLs: [1]string{"https://eapi.binance.com"},
+	OptionTypes: Options_OptionTypes_ENUM{
+		CALL: "CALL",
+		PUT:  "PUT",
+	},
+	OrderTypes: Options_OrderTypes_ENUM{
+		LIMIT: "LIMIT",
+	},
+	OrderStatuses: Options_OrderStatuses_ENUM{
+		ACCEPTED:         "ACCEPTED",
+		REJECTED:         "REJECTED",
+		PARTIALLY_FILLED: "PARTIALLY_FILLED",
+		FILLED:           "FILLED",
+		CANCELLED:        "CANCELLED",
+	},
+	TimeInForce: Options_TimeInForce_ENUM{
+		GTC: "GTC",
+		IOC: "IOC",
+		FOK: "FOK",
+	},
+	StrikeResults: Options_StrikeResults_ENUM{
+		REALISTIC_VALUE_STRICKEN: "REALISTIC_VALUE_STRICKEN",
+		EXTRINSIC_VALUE_EXPIRED:  "EXTRINSIC_VALUE_EXPIRED",
+	},
+	SymbolFilterType: Options_SymbolFilterTypes_ENUM{
+		PRICE_FILTER: "PRICE_FILTER",
+		LOT_SIZE:     "LOT_SIZE",
+	},
+	Websocket: Options_Websocket_Constants{
+		URLs: []string{"wss://nbstream.binance.com/eoptions"},
+	},
+}
+
+type Options_OptionTypes_ENUM struct {
+	CALL string
+	PUT  string
+}
+
+type Options_OrderTypes_ENUM struct {
+	LIMIT string
+}
+
+type Options_OrderStatuses_ENUM struct {
+	ACCEPTED         string
+	REJECTED         string
+	PARTIALLY_FILLED string
+	FILLED           string
+	CANCELLED        string
+}
+
+type Options_TimeInForce_ENUM struct {
+	GTC string
+	IOC string
+	FOK string
+}
+
+type Options_StrikeResults_ENUM struct {
+	REALISTIC_VALUE_STRICKEN string
+	EXTRINSIC_VALUE_EXPIRED  string
+}
+
+type Options_SymbolFilterTypes_ENUM struct {
+	PRICE_FILTER string
+	LOT_SIZE     string
+}
+
+type Options_Websocket_Constants struct {
+	URLs []string
+}
+
+//////////////////////////////////////
+//////////////////////////////////////
+//////////////////////////////////////
+
+// # Option symbol
+//
+// Option symbols are formatted as "<underlyingAsset>-<YYMMDD>-<strike>-<C|P>", i.e: "BTC-240329-70000-C"
+type Options_SymbolInfo struct {
+	Symbol string
+
+	// i.e: "BTC"
+	UnderlyingAsset string
+
+	// "YYMMDD", as found in the symbol
+	ExpiryDate string
+
+	// Options expire at 08:00 UTC on their expiry date
+	Expiry time.Time
+
+	Strike string
+
+	// "CALL" | "PUT"
+	OptionType string
+}
+
+// Parses an option symbol into its underlying asset, expiry, strike and type
+//
+// Returns an INVALID_VALUE_ERR if the symbol isn't formatted as "<underlyingAsset>-<YYMMDD>-<strike>-<C|P>"
+func ParseOptionSymbol(symbol string) (*Options_SymbolInfo, *Error) {
+	parts := strings.Split(symbol, "-")
+	if len(parts) != 4 {
+		return nil, LocalError(INVALID_VALUE_ERR, "Invalid option symbol '"+symbol+"', expected '<underlyingAsset>-<YYMMDD>-<strike>-<C|P>'")
+	}
+
+	expiry, err := time.Parse("060102", parts[1])
+	if err != nil {
+		return nil, LocalError(INVALID_VALUE_ERR, "Invalid expiry date in option symbol '"+symbol+"': "+err.Error())
+	}
+
+	info := &Options_SymbolInfo{
+		Symbol:          symbol,
+		UnderlyingAsset: parts[0],
+		ExpiryDate:      parts[1],
+		Expiry:          expiry.Add(8 * time.Hour),
+		Strike:          parts[2],
+	}
+
+	switch parts[3] {
+	case "C":
+		info.OptionType = OPTIONS_Constants.OptionTypes.CALL
+	case "P":
+		info.OptionType = OPTIONS_Constants.OptionTypes.PUT
+	default:
+		return nil, LocalError(INVALID_VALUE_ERR, "Invalid option type in option symbol '"+symbol+"', expected 'C' or 'P'")
+	}
+
+	if info.UnderlyingAsset == "" || info.Strike == "" {
+		return nil, LocalError(INVALID_VALUE_ERR, "Invalid option symbol '"+symbol+"', expected '<underlyingAsset>-<YYMMDD>-<strike>-<C|P>'")
+	}
+
+	return info, nil
+}
+
+// Creates the option symbol from its parts, the inverse of ParseOptionSymbol
+func CreateOptionSymbol(underlyingAsset string, expiry time.Time, strike string, optionType string) string {
+	typeLetter := "C"
+	if optionType == OPTIONS_Constants.OptionTypes.PUT {
+		typeLetter = "P"
+	}
+
+	return underlyingAsset + "-" + expiry.UTC().Format("060102") + "-" + strike + "-" + typeLetter
+}
+
+//////////////////////////////////////
+//////////////////////////////////////
+//////////////////////////////////////
+
+type Options_Time struct {
+	ServerTime int64 `json:"serverTime"`
+
+	Latency int64
+}
+
+type Options_ExchangeInfo struct {
+	Timezone        string                   `json:"timezone"`
+	ServerTime      int64                    `json:"serverTime"`
+	OptionContracts []*Options_Contract      `json:"optionContracts"`
+	OptionAssets    []*Options_Asset         `json:"optionAssets"`
+	Symbols_arr     []*Options_Symbol        `json:"optionSymbols"`
+	RateLimits      []*Futures_RateLimitType `json:"rateLimits"`
+
+	Symbols struct {
+		Mu  sync.Mutex
+		Map map[string]*Options_Symbol
+	}
+}
+
+type Options_Contract struct {
+	Id          int64  `json:"id"`
+	BaseAsset   string `json:"baseAsset"`
+	QuoteAsset  string `json:"quoteAsset"`
+	Underlying  string `json:"underlying"`
+	SettleAsset string `json:"settleAsset"`
+}
+
+type Options_Asset struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type Options_Symbol struct {
+	ContractId int64 `json:"contractId"`
+	ExpiryDate int64 `json:"expiryDate"`
+	// "PRICE_FILTER" and "LOT_SIZE" are the only filters, fields are only set for their own filterType
+	Filters     []*Options_SymbolFilter `json:"filters"`
+	Id          int64                   `json:"id"`
+	Symbol      string                  `json:"symbol"`
+	Side        string                  `json:"side"`
+	StrikePrice Decimal                 `json:"strikePrice"`
+	Underlying  string                  `json:"underlying"`
+	// Contract unit, the quantity of the underlying asset represented by a single contract
+	Unit                 int64   `json:"unit"`
+	MakerFeeRate         string  `json:"makerFeeRate"`
+	TakerFeeRate         string  `json:"takerFeeRate"`
+	MinQty               Decimal `json:"minQty"`
+	MaxQty               Decimal `json:"maxQty"`
+	InitialMargin        string  `json:"initialMargin"`
+	MaintenanceMargin    string  `json:"maintenanceMargin"`
+	MinInitialMargin     string  `json:"minInitialMargin"`
+	MinMaintenanceMargin string  `json:"minMaintenanceMargin"`
+	PriceScale           int64   `json:"priceScale"`
+	QuantityScale        int64   `json:"quantityScale"`
+	QuoteAsset           string  `json:"quoteAsset"`
+}
+
+type Options_SymbolFilter struct {
+	FilterType string `json:"filterType"`
+
+	// PRICE_FILTER
+	MinPrice Decimal `json:"minPrice"`
+	MaxPrice Decimal `json:"maxPrice"`
+	TickSize Decimal `json:"tickSize"`
+
+	// LOT_SIZE
+	MinQty   Decimal `json:"minQty"`
+	MaxQty   Decimal `json:"maxQty"`
+	StepSize Decimal `json:"stepSize"`
+}
+
+type Options_OrderBook struct {
+	TransactTime int64        `json:"T"`
+	UpdateId     int64        `json:"u"`
+	Bids         [][2]Decimal `json:"bids"`
+	Asks         [][2]Decimal `json:"asks"`
+}
+
+type Options_Candlestick struct {
+	Open        string  `json:"open"`
+	High        string  `json:"high"`
+	Low         string  `json:"low"`
+	Close       string  `json:"close"`
+	Volume      Decimal `json:"volume"`
+	Amount      string  `json:"amount"`
+	Interval    string  `json:"interval"`
+	TradeCount  int64   `json:"tradeCount"`
+	TakerVolume Decimal `json:"takerVolume"`
+	TakerAmount string  `json:"takerAmount"`
+	OpenTime    int64   `json:"openTime"`
+	CloseTime   int64   `json:"closeTime"`
+}
+
+type Options_MarkPrice struct {
+	Symbol           string  `json:"symbol"`
+	MarkPrice        Decimal `json:"markPrice"`
+	BidIV            string  `json:"bidIV"`
+	AskIV            string  `json:"askIV"`
+	MarkIV           string  `json:"markIV"`
+	Delta            string  `json:"delta"`
+	Theta            string  `json:"theta"`
+	Gamma            string  `json:"gamma"`
+	Vega             string  `json:"vega"`
+	HighPriceLimit   Decimal `json:"highPriceLimit"`
+	LowPriceLimit    Decimal `json:"lowPriceLimit"`
+	RiskFreeInterest string  `json:"riskFreeInterest"`
+}
+
+type Options_IndexPrice struct {
+	Time       int64   `json:"time"`
+	IndexPrice Decimal `json:"indexPrice"`
+}
+
+type Options_OpenInterest struct {
+	Symbol             string `json:"symbol"`
+	SumOpenInterest    string `json:"sumOpenInterest"`
+	SumOpenInterestUsd string `json:"sumOpenInterestUsd"`
+	Timestamp          string `json:"timestamp"`
+}
+
+type Options_ExerciseHistory struct {
+	Symbol          string  `json:"symbol"`
+	StrikePrice     Decimal `json:"strikePrice"`
+	RealStrikePrice Decimal `json:"realStrikePrice"`
+	ExpiryDate      int64   `json:"expiryDate"`
+	// Possible values are in OPTIONS_Constants.StrikeResults
+	StrikeResult string `json:"strikeResult"`
+}
+
+//////////////////////////////////////
+//////////////////////////////////////
+//////////////////////////////////////
+
+type Options_Order struct {
+	OrderId       int64   `json:"orderId"`
+	Symbol        string  `json:"symbol"`
+	Price         Decimal `json:"price"`
+	Quantity      Decimal `json:"quantity"`
+	ExecutedQty   Decimal `json:"executedQty"`
+	Fee           string  `json:"fee"`
+	Side          string  `json:"side"`
+	Type          string  `json:"type"`
+	TimeInForce   string  `json:"timeInForce"`
+	ReduceOnly    bool    `json:"reduceOnly"`
+	PostOnly      bool    `json:"postOnly"`
+	CreateTime    int64   `json:"createTime"`
+	UpdateTime    int64   `json:"updateTime"`
+	Status        string  `json:"status"`
+	AvgPrice      Decimal `json:"avgPrice"`
+	ClientOrderId string  `json:"clientOrderId"`
+	PriceScale    int64   `json:"priceScale"`
+	QuantityScale int64   `json:"quantityScale"`
+	// "CALL" | "PUT"
+	OptionSide string `json:"optionSide"`
+	QuoteAsset string `json:"quoteAsset"`
+	// is market maker protection order
+	Mmp bool `json:"mmp"`
+}
+
+type Options_CancelAllOrders_Response struct {
+	// 0 for success
+	Code int `json:"code"`
+	// "success"
+	Msg string `json:"msg"`
+}
+
+type Options_Position struct {
+	EntryPrice Decimal `json:"entryPrice"`
+	Symbol     string  `json:"symbol"`
+	// "LONG" | "SHORT"
+	Side          string  `json:"side"`
+	Quantity      Decimal `json:"quantity"`
+	ReducibleQty  Decimal `json:"reducibleQty"`
+	MarkValue     string  `json:"markValue"`
+	Ror           string  `json:"ror"`
+	UnrealizedPNL string  `json:"unrealizedPNL"`
+	MarkPrice     Decimal `json:"markPrice"`
+	StrikePrice   Decimal `json:"strikePrice"`
+	PositionCost  string  `json:"positionCost"`
+	ExpiryDate    int64   `json:"expiryDate"`
+	PriceScale    int64   `json:"priceScale"`
+	QuantityScale int64   `json:"quantityScale"`
+	// "CALL" | "PUT"
+	OptionSide string `json:"optionSide"`
+	QuoteAsset string `json:"quoteAsset"`
+}
+
+type Options_AccountInfo struct {
+	Assets []*Options_AccountInfo_Asset `json:"asset"`
+	Greeks []*Options_AccountInfo_Greek `json:"greek"`
+	Time   int64                        `json:"time"`
+	// "NORMAL" | "REDUCE_ONLY"
+	RiskLevel string `json:"riskLevel"`
+}
+
+type Options_AccountInfo_Asset struct {
+	Asset         string `json:"asset"`
+	MarginBalance string `json:"marginBalance"`
+	Equity        string `json:"equity"`
+	Available     string `json:"available"`
+	Locked        string `json:"locked"`
+	UnrealizedPNL string `json:"unrealizedPNL"`
+}
+
+type Options_AccountInfo_Greek struct {
+	Underlying string `json:"underlying"`
+	Delta      string `json:"delta"`
+	Gamma      string `json:"gamma"`
+	Theta      string `json:"theta"`
+	Vega       string `json:"vega"`
+}
@@ -0,0 +1,587 @@
+package Binance
+
+import "strconv"
+
+// Options websockets use the same SUBSCRIBE/UNSUBSCRIBE protocol as the Futures ones
+//
+// Stream names are case sensitive, symbols and underlying assets are uppercase (i.e: "BTC-240329-70000-C@ticker", "BTC@markPrice")
+type Options_Websockets struct {
+	binance *Binance
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type OptionsWS_IndexPrice struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// Underlying symbol
+	Underlying string `json:"s"`
+
+	// Index price
+	IndexPrice string `json:"p"`
+}
+
+type OptionsWS_IndexPrice_Socket struct {
+	Handler *Futures_Websocket
+}
+
+// "underlying" is the spot pair, i.e: "BTCUSDT"
+func (*OptionsWS_IndexPrice_Socket) CreateStreamName(underlying ...string) []string {
+	streamNames := make([]string, len(underlying))
+	for i := range underlying {
+		streamNames[i] = underlying[i] + "@index"
+	}
+	return streamNames
+}
+
+func (socket *OptionsWS_IndexPrice_Socket) Subscribe(underlying ...string) (resp *FuturesWS_Subscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(underlying...)
+	return socket.Handler.Subscribe(streamNames...)
+}
+
+func (socket *OptionsWS_IndexPrice_Socket) Unsubscribe(underlying ...string) (resp *FuturesWS_Unsubscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(underlying...)
+	return socket.Handler.Unsubscribe(streamNames...)
+}
+
+func (options_ws *Options_Websockets) IndexPrice(publicOnMessage func(indexPrice *OptionsWS_IndexPrice), underlying ...string) (*OptionsWS_IndexPrice_Socket, *Error) {
+	var newSocket OptionsWS_IndexPrice_Socket
+
+	streamNames := newSocket.CreateStreamName(underlying...)
+
+	socket, err := options_ws.CreateSocket(streamNames, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		var indexPrice OptionsWS_IndexPrice
+		err := json.Unmarshal(msg, &indexPrice)
+		if err != nil {
+			socket.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		publicOnMessage(&indexPrice)
+	})
+
+	newSocket.Handler = socket
+	return &newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type OptionsWS_MarkPrice struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// Option symbol
+	Symbol string `json:"s"`
+
+	// Option mark price
+	MarkPrice string `json:"mp"`
+}
+
+type OptionsWS_MarkPrice_Socket struct {
+	Handler *Futures_Websocket
+}
+
+// "underlyingAsset" i.e: "BTC"
+func (*OptionsWS_MarkPrice_Socket) CreateStreamName(underlyingAsset ...string) []string {
+	streamNames := make([]string, len(underlyingAsset))
+	for i := range underlyingAsset {
+		streamNames[i] = underlyingAsset[i] + "@markPrice"
+	}
+	return streamNames
+}
+
+func (socket *OptionsWS_MarkPrice_Socket) Subscribe(underlyingAsset ...string) (resp *FuturesWS_Subscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(underlyingAsset...)
+	return socket.Handler.Subscribe(streamNames...)
+}
+
+func (socket *OptionsWS_MarkPrice_Socket) Unsubscribe(underlyingAsset ...string) (resp *FuturesWS_Unsubscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(underlyingAsset...)
+	return socket.Handler.Unsubscribe(streamNames...)
+}
+
+// The mark prices of all the options of an underlying asset are pushed together
+func (options_ws *Options_Websockets) MarkPrice(publicOnMessage func(markPrices []*OptionsWS_MarkPrice), underlyingAsset ...string) (*OptionsWS_MarkPrice_Socket, *Error) {
+	var newSocket OptionsWS_MarkPrice_Socket
+
+	streamNames := newSocket.CreateStreamName(underlyingAsset...)
+
+	socket, err := options_ws.CreateSocket(streamNames, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		var markPrices []*OptionsWS_MarkPrice
+		err := json.Unmarshal(msg, &markPrices)
+		if err != nil {
+			socket.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		publicOnMessage(markPrices)
+	})
+
+	newSocket.Handler = socket
+	return &newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type OptionsWS_Ticker struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// Transaction time
+	TransactionTime int64 `json:"T"`
+
+	// Option symbol
+	Symbol string `json:"s"`
+
+	// 24-hour opening price
+	Open string `json:"o"`
+
+	// Highest price
+	High string `json:"h"`
+
+	// Lowest price
+	Low string `json:"l"`
+
+	// Latest price
+	Close string `json:"c"`
+
+	// Trading volume (in contracts)
+	Volume string `json:"V"`
+
+	// Trade amount (in quote asset)
+	Amount string `json:"A"`
+
+	// Price change percent
+	PriceChangePercent string `json:"P"`
+
+	// Price change
+	PriceChange string `json:"p"`
+
+	// Volume of last completed trade (in contracts)
+	LastQty string `json:"Q"`
+
+	// First trade ID
+	FirstTradeId int64 `json:"F"`
+
+	// Last trade ID
+	LastTradeId int64 `json:"L"`
+
+	// Number of trades
+	TradeCount int64 `json:"n"`
+
+	// The depth of best bid
+	BestBidQty string `json:"bo"`
+
+	// The depth of best ask
+	BestAskQty string `json:"ao"`
+
+	// Best bid qty
+	BidQty string `json:"bq"`
+
+	// Best ask qty
+	AskQty string `json:"aq"`
+
+	// Buy implied volatility
+	BidIV string `json:"b"`
+
+	// Sell implied volatility
+	AskIV string `json:"a"`
+
+	// Delta
+	Delta string `json:"d"`
+
+	// Theta
+	Theta string `json:"t"`
+
+	// Gamma
+	Gamma string `json:"g"`
+
+	// Vega
+	Vega string `json:"v"`
+
+	// Implied volatility
+	IV string `json:"vo"`
+
+	// Mark price
+	MarkPrice string `json:"mp"`
+
+	// Buy maximum price
+	HighPriceLimit string `json:"hl"`
+
+	// Sell minimum price
+	LowPriceLimit string `json:"ll"`
+
+	// Estimated strike price (return estimated strike price for half hour before exercise)
+	EstimatedStrikePrice string `json:"eep"`
+}
+
+type OptionsWS_Ticker_Socket struct {
+	Handler *Futures_Websocket
+}
+
+func (*OptionsWS_Ticker_Socket) CreateStreamName(symbol ...string) []string {
+	streamNames := make([]string, len(symbol))
+	for i := range symbol {
+		streamNames[i] = symbol[i] + "@ticker"
+	}
+	return streamNames
+}
+
+func (socket *OptionsWS_Ticker_Socket) Subscribe(symbol ...string) (resp *FuturesWS_Subscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(symbol...)
+	return socket.Handler.Subscribe(streamNames...)
+}
+
+func (socket *OptionsWS_Ticker_Socket) Unsubscribe(symbol ...string) (resp *FuturesWS_Unsubscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(symbol...)
+	return socket.Handler.Unsubscribe(streamNames...)
+}
+
+func (options_ws *Options_Websockets) Ticker(publicOnMessage func(ticker *OptionsWS_Ticker), symbol ...string) (*OptionsWS_Ticker_Socket, *Error) {
+	var newSocket OptionsWS_Ticker_Socket
+
+	streamNames := newSocket.CreateStreamName(symbol...)
+
+	socket, err := options_ws.CreateSocket(streamNames, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		var ticker OptionsWS_Ticker
+		err := json.Unmarshal(msg, &ticker)
+		if err != nil {
+			socket.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		publicOnMessage(&ticker)
+	})
+
+	newSocket.Handler = socket
+	return &newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type OptionsWS_Expiration_Params struct {
+	// i.e: "BTC"
+	UnderlyingAsset string
+
+	// "YYMMDD", i.e: "240329"
+	ExpirationDate string
+}
+
+type OptionsWS_ExpirationTicker_Socket struct {
+	Handler *Futures_Websocket
+}
+
+func (*OptionsWS_ExpirationTicker_Socket) CreateStreamName(params ...OptionsWS_Expiration_Params) []string {
+	streamNames := make([]string, len(params))
+	for i := range params {
+		streamNames[i] = params[i].UnderlyingAsset + "@ticker@" + params[i].ExpirationDate
+	}
+	return streamNames
+}
+
+func (socket *OptionsWS_ExpirationTicker_Socket) Subscribe(params ...OptionsWS_Expiration_Params) (resp *FuturesWS_Subscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(params...)
+	return socket.Handler.Subscribe(streamNames...)
+}
+
+func (socket *OptionsWS_ExpirationTicker_Socket) Unsubscribe(params ...OptionsWS_Expiration_Params) (resp *FuturesWS_Unsubscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(params...)
+	return socket.Handler.Unsubscribe(streamNames...)
+}
+
+// The tickers of all the options of an underlying asset for a specific expiration date are pushed together
+func (options_ws *Options_Websockets) ExpirationTicker(publicOnMessage func(tickers []*OptionsWS_Ticker), params ...OptionsWS_Expiration_Params) (*OptionsWS_ExpirationTicker_Socket, *Error) {
+	var newSocket OptionsWS_ExpirationTicker_Socket
+
+	streamNames := newSocket.CreateStreamName(params...)
+
+	socket, err := options_ws.CreateSocket(streamNames, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		var tickers []*OptionsWS_Ticker
+		err := json.Unmarshal(msg, &tickers)
+		if err != nil {
+			socket.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		publicOnMessage(tickers)
+	})
+
+	newSocket.Handler = socket
+	return &newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type OptionsWS_OpenInterest struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// Option symbol
+	Symbol string `json:"s"`
+
+	// Open interest in contracts
+	OpenInterest string `json:"o"`
+
+	// Open interest in USDT
+	OpenInterestUSDT string `json:"h"`
+}
+
+type OptionsWS_OpenInterest_Socket struct {
+	Handler *Futures_Websocket
+}
+
+func (*OptionsWS_OpenInterest_Socket) CreateStreamName(params ...OptionsWS_Expiration_Params) []string {
+	streamNames := make([]string, len(params))
+	for i := range params {
+		streamNames[i] = params[i].UnderlyingAsset + "@openInterest@" + params[i].ExpirationDate
+	}
+	return streamNames
+}
+
+func (socket *OptionsWS_OpenInterest_Socket) Subscribe(params ...OptionsWS_Expiration_Params) (resp *FuturesWS_Subscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(params...)
+	return socket.Handler.Subscribe(streamNames...)
+}
+
+func (socket *OptionsWS_OpenInterest_Socket) Unsubscribe(params ...OptionsWS_Expiration_Params) (resp *FuturesWS_Unsubscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(params...)
+	return socket.Handler.Unsubscribe(streamNames...)
+}
+
+// The open interests of all the options of an underlying asset for a specific expiration date are pushed together
+func (options_ws *Options_Websockets) OpenInterest(publicOnMessage func(openInterests []*OptionsWS_OpenInterest), params ...OptionsWS_Expiration_Params) (*OptionsWS_OpenInterest_Socket, *Error) {
+	var newSocket OptionsWS_OpenInterest_Socket
+
+	streamNames := newSocket.CreateStreamName(params...)
+
+	socket, err := options_ws.CreateSocket(streamNames, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		var openInterests []*OptionsWS_OpenInterest
+		err := json.Unmarshal(msg, &openInterests)
+		if err != nil {
+			socket.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		publicOnMessage(openInterests)
+	})
+
+	newSocket.Handler = socket
+	return &newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type OptionsWS_Trade struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// Option symbol
+	Symbol string `json:"s"`
+
+	// Trade ID
+	TradeId int64 `json:"t"`
+
+	// Price
+	Price string `json:"p"`
+
+	// Quantity
+	Quantity string `json:"q"`
+
+	// Buy order ID
+	BuyerOrderId int64 `json:"b"`
+
+	// Sell order ID
+	SellerOrderId int64 `json:"a"`
+
+	// Trade time
+	Timestamp int64 `json:"T"`
+
+	// Direction, -1 for taker sell, 1 for taker buy
+	Side string `json:"S"`
+}
+
+type OptionsWS_Trade_Socket struct {
+	Handler *Futures_Websocket
+}
+
+// Either an option symbol (i.e: "BTC-240329-70000-C") or an underlying asset (i.e: "BTC") for all of its options
+func (*OptionsWS_Trade_Socket) CreateStreamName(symbolOrUnderlyingAsset ...string) []string {
+	streamNames := make([]string, len(symbolOrUnderlyingAsset))
+	for i := range symbolOrUnderlyingAsset {
+		streamNames[i] = symbolOrUnderlyingAsset[i] + "@trade"
+	}
+	return streamNames
+}
+
+func (socket *OptionsWS_Trade_Socket) Subscribe(symbolOrUnderlyingAsset ...string) (resp *FuturesWS_Subscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(symbolOrUnderlyingAsset...)
+	return socket.Handler.Subscribe(streamNames...)
+}
+
+func (socket *OptionsWS_Trade_Socket) Unsubscribe(symbolOrUnderlyingAsset ...string) (resp *FuturesWS_Unsubscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(symbolOrUnderlyingAsset...)
+	return socket.Handler.Unsubscribe(streamNames...)
+}
+
+func (options_ws *Options_Websockets) Trades(publicOnMessage func(trade *OptionsWS_Trade), symbolOrUnderlyingAsset ...string) (*OptionsWS_Trade_Socket, *Error) {
+	var newSocket OptionsWS_Trade_Socket
+
+	streamNames := newSocket.CreateStreamName(symbolOrUnderlyingAsset...)
+
+	socket, err := options_ws.CreateSocket(streamNames, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		var trade OptionsWS_Trade
+		err := json.Unmarshal(msg, &trade)
+		if err != nil {
+			socket.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		publicOnMessage(&trade)
+	})
+
+	newSocket.Handler = socket
+	return &newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type OptionsWS_Depth struct {
+
+	// Event type
+	Event string `json:"e"`
+
+	// Event time
+	EventTime int64 `json:"E"`
+
+	// Transaction time
+	TransactionTime int64 `json:"T"`
+
+	// Option symbol
+	Symbol string `json:"s"`
+
+	// Update ID
+	UpdateId int64 `json:"u"`
+
+	// Same as update ID in event
+	PreviousUpdateId int64 `json:"pu"`
+
+	// Bids
+	Bids [][2]string `json:"b"`
+
+	// Asks
+	Asks [][2]string `json:"a"`
+}
+
+type OptionsWS_Depth_Params struct {
+	Symbol string
+
+	// 10, 20, 50 or 100
+	Levels int64
+
+	// 100 or 1000 milliseconds, 500ms is used by Binance if omitted
+	UpdateSpeed_ms int64
+}
+
+type OptionsWS_Depth_Socket struct {
+	Handler *Futures_Websocket
+}
+
+func (*OptionsWS_Depth_Socket) CreateStreamName(params ...OptionsWS_Depth_Params) []string {
+	streamNames := make([]string, len(params))
+	for i := range params {
+		streamNames[i] = params[i].Symbol + "@depth" + strconv.FormatInt(params[i].Levels, 10)
+		if params[i].UpdateSpeed_ms != 0 {
+			streamNames[i] += "@" + strconv.FormatInt(params[i].UpdateSpeed_ms, 10) + "ms"
+		}
+	}
+	return streamNames
+}
+
+func (socket *OptionsWS_Depth_Socket) Subscribe(params ...OptionsWS_Depth_Params) (resp *FuturesWS_Subscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(params...)
+	return socket.Handler.Subscribe(streamNames...)
+}
+
+func (socket *OptionsWS_Depth_Socket) Unsubscribe(params ...OptionsWS_Depth_Params) (resp *FuturesWS_Unsubscribe_Response, hasTimedOut bool, err *Error) {
+	streamNames := socket.CreateStreamName(params...)
+	return socket.Handler.Unsubscribe(streamNames...)
+}
+
+func (options_ws *Options_Websockets) Depth(publicOnMessage func(depth *OptionsWS_Depth), params ...OptionsWS_Depth_Params) (*OptionsWS_Depth_Socket, *Error) {
+	var newSocket OptionsWS_Depth_Socket
+
+	streamNames := newSocket.CreateStreamName(params...)
+
+	socket, err := options_ws.CreateSocket(streamNames, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		var depth OptionsWS_Depth
+		err := json.Unmarshal(msg, &depth)
+		if err != nil {
+			socket.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		publicOnMessage(&depth)
+	})
+
+	newSocket.Handler = socket
+	return &newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (*Options_Websockets) CreateSocket(streams []string, isCombined bool) (*Futures_Websocket, *Error) {
+	return createFuturesSocket(OPTIONS_Constants.Websocket.URLs[0], streams, isCombined)
+}
@@ -0,0 +1,486 @@
+package Binance
+
+import (
+	"fmt"
+)
+
+// # A filter the order would be rejected for
+type OrderViolation struct {
+	// The filterType, i.e: "PRICE_FILTER", "EXCHANGE_MAX_NUM_ORDERS"
+	Filter string
+	// i.e: "minPrice", "tickSize", "minNotional"
+	Reason string
+	// The order field to correct: "price", "stopPrice", "quantity", "quoteOrderQty", "icebergQty", "trailingDelta" or "openOrders"
+	Field string
+	Value Decimal
+	// Corrected value for "Field", must be ignored if "HasSuggestion" is false
+	Suggestion    Decimal
+	HasSuggestion bool
+	Message       string
+}
+
+// Collects every violation of an order, parsing errors stop the validation
+type orderValidator struct {
+	violations []*OrderViolation
+	err        *Error
+}
+
+func (validator *orderValidator) parse(value string) Decimal {
+	if value == "" || validator.err != nil {
+		return Decimal{}
+	}
+
+	parsed, parseErr := ParseDecimal(value)
+	if parseErr != nil {
+		validator.err = LocalError(PARSING_ERR, parseErr.Error())
+		return Decimal{}
+	}
+
+	return parsed
+}
+
+func (validator *orderValidator) add(filter string, reason string, field string, value Decimal, message string) {
+	validator.violations = append(validator.violations, &OrderViolation{
+		Filter:  filter,
+		Reason:  reason,
+		Field:   field,
+		Value:   value,
+		Message: message,
+	})
+}
+
+func (validator *orderValidator) suggest(filter string, reason string, field string, value Decimal, suggestion Decimal, message string) {
+	validator.violations = append(validator.violations, &OrderViolation{
+		Filter:        filter,
+		Reason:        reason,
+		Field:         field,
+		Value:         value,
+		Suggestion:    suggestion,
+		HasSuggestion: true,
+		Message:       message,
+	})
+}
+
+// Checks the min, max and step of PRICE_FILTER, LOT_SIZE and MARKET_LOT_SIZE style filters, "0" disables a bound
+func (validator *orderValidator) checkRange(filter string, field string, value Decimal, min Decimal, max Decimal, step Decimal, minReason string, maxReason string, stepReason string) {
+	if !min.IsZero() && value.LessThan(min) {
+		validator.suggest(filter, minReason, field, value, min, fmt.Sprintf("%s %v is below %s %v", field, value, minReason, min))
+	} else if !max.IsZero() && value.GreaterThan(max) {
+		validator.suggest(filter, maxReason, field, value, max, fmt.Sprintf("%s %v is above %s %v", field, value, maxReason, max))
+	} else if !value.IsMultipleOf(step) {
+		validator.suggest(filter, stepReason, field, value, value.RoundToStep(step, ROUND_FLOOR), fmt.Sprintf("%s %v is not a multiple of %s %v", field, value, stepReason, step))
+	}
+}
+
+func (validator *orderValidator) checkOpenOrders(filter string, openOrders int64, max int64) {
+	if max != 0 && openOrders+1 > max {
+		validator.add(filter, "maxNumOrders", "openOrders", NewDecimal(openOrders, 0), fmt.Sprintf("%d open orders already, %s allows %d", openOrders, filter, max))
+	}
+}
+
+// # Checks a single PRICE_FILTER, LOT_SIZE or MARKET_LOT_SIZE style filter
+//
+// Returns the first violation's reason and suggestion, or "value" if it passes
+func checkFilterRange(filter string, field string, value Decimal, min Decimal, max Decimal, step Decimal, minReason string, maxReason string, stepReason string) (isValid bool, reason string, suggestion Decimal) {
+	var validator orderValidator
+	validator.checkRange(filter, field, value, min, max, step, minReason, maxReason, stepReason)
+	if len(validator.violations) == 0 {
+		return true, "", value
+	}
+
+	violation := validator.violations[0]
+	return false, violation.Reason, violation.Suggestion
+}
+
+// Decimals kept by divisions before rounding to a step, beyond any precision Binance uses
+const orderValidation_divisionScale = 16
+
+// Returns value / divisor rounded to a multiple of "step" with "mode", "divisor" must not be 0
+//
+// A zero "step" keeps orderValidation_divisionScale decimals.
+func divToStep(value Decimal, divisor Decimal, step Decimal, mode RoundingMode) Decimal {
+	quotient, _ := value.Div(divisor, orderValidation_divisionScale, mode)
+	return quotient.RoundToStep(step, mode)
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Spot_ValidateOrder_Params struct {
+	// One of SPOT_Constants.OrderSides
+	Side string
+	// One of SPOT_Constants.OrderTypes
+	Type string
+
+	// Limit price, not used by MARKET, STOP_LOSS and TAKE_PROFIT orders
+	Price    Decimal
+	Quantity Decimal
+	// MARKET orders only, instead of "Quantity"
+	QuoteOrderQty Decimal
+	StopPrice     Decimal
+	TrailingDelta int64
+	IcebergQty    Decimal
+
+	// Average price of the symbol (Spot.AveragePrice), used by PERCENT_PRICE, PERCENT_PRICE_BY_SIDE, and by MIN_NOTIONAL and NOTIONAL for market orders
+	//
+	// These filters are skipped if it is 0
+	AveragePrice Decimal
+
+	// Open orders on the symbol, for MAX_NUM_ORDERS, MAX_NUM_ALGO_ORDERS and MAX_NUM_ICEBERG_ORDERS
+	OpenOrders        int64
+	OpenAlgoOrders    int64
+	OpenIcebergOrders int64
+
+	// Open orders on the account, for the exchange filters
+	AccountOpenOrders        int64
+	AccountOpenAlgoOrders    int64
+	AccountOpenIcebergOrders int64
+
+	// Base asset balance (free + locked) plus the quantity of the open BUY orders, for MAX_POSITION
+	Position Decimal
+}
+
+func spotIsMarketType(orderType string) bool {
+	return orderType == SPOT_Constants.OrderTypes.MARKET || orderType == SPOT_Constants.OrderTypes.STOP_LOSS || orderType == SPOT_Constants.OrderTypes.TAKE_PROFIT
+}
+
+func spotIsAlgoType(orderType string) bool {
+	return orderType == SPOT_Constants.OrderTypes.STOP_LOSS || orderType == SPOT_Constants.OrderTypes.STOP_LOSS_LIMIT || orderType == SPOT_Constants.OrderTypes.TAKE_PROFIT || orderType == SPOT_Constants.OrderTypes.TAKE_PROFIT_LIMIT
+}
+
+// # Checks the order against every filter of the symbol
+//
+// Returns every violation found (nil if the order passes), each with the field to correct and a suggested value when one can be computed.
+//
+// Use 'Spot_ExchangeInfo.ValidateOrder' to also check the exchange filters.
+func (spotSymbol *Spot_Symbol) ValidateOrder(order Spot_ValidateOrder_Params) (violations []*OrderViolation, err *Error) {
+	validator := &orderValidator{}
+	filters := spotSymbol.Filters
+	isMarket := spotIsMarketType(order.Type)
+
+	var tickSize Decimal
+	if filters.PRICE_FILTER != nil {
+		filter := filters.PRICE_FILTER
+		tickSize = filter.TickSize
+		if !isMarket && !order.Price.IsZero() {
+			validator.checkRange(filter.FilterType, "price", order.Price, filter.MinPrice, filter.MaxPrice, filter.TickSize, "minPrice", "maxPrice", "tickSize")
+		}
+		if !order.StopPrice.IsZero() {
+			validator.checkRange(filter.FilterType, "stopPrice", order.StopPrice, filter.MinPrice, filter.MaxPrice, filter.TickSize, "minPrice", "maxPrice", "tickSize")
+		}
+	}
+
+	if filters.PERCENT_PRICE != nil && !isMarket && !order.Price.IsZero() && !order.AveragePrice.IsZero() {
+		filter := filters.PERCENT_PRICE
+		validator.checkPercentPrice(filter.FilterType, order.Price, order.AveragePrice, filter.MultiplierUp, filter.MultiplierDown, tickSize)
+	}
+
+	if filters.PERCENT_PRICE_BY_SIDE != nil && !isMarket && !order.Price.IsZero() && !order.AveragePrice.IsZero() {
+		filter := filters.PERCENT_PRICE_BY_SIDE
+		if order.Side == SPOT_Constants.OrderSides.BUY {
+			validator.checkPercentPrice(filter.FilterType, order.Price, order.AveragePrice, filter.BidMultiplierUp, filter.BidMultiplierDown, tickSize)
+		} else {
+			validator.checkPercentPrice(filter.FilterType, order.Price, order.AveragePrice, filter.AskMultiplierUp, filter.AskMultiplierDown, tickSize)
+		}
+	}
+
+	if filters.LOT_SIZE != nil {
+		filter := filters.LOT_SIZE
+		if !order.Quantity.IsZero() {
+			validator.checkRange(filter.FilterType, "quantity", order.Quantity, filter.MinQty, filter.MaxQty, filter.StepSize, "minQty", "maxQty", "stepSize")
+		}
+		if !order.IcebergQty.IsZero() {
+			validator.checkRange(filter.FilterType, "icebergQty", order.IcebergQty, filter.MinQty, filter.MaxQty, filter.StepSize, "minQty", "maxQty", "stepSize")
+		}
+	}
+
+	if filters.MARKET_LOT_SIZE != nil && isMarket && !order.Quantity.IsZero() {
+		filter := filters.MARKET_LOT_SIZE
+		validator.checkRange(filter.FilterType, "quantity", order.Quantity, filter.MinQty, filter.MaxQty, filter.StepSize, "minQty", "maxQty", "stepSize")
+	}
+
+	// Notional value of the order, 0 if it can't be known
+	notional := order.Price.Mul(order.Quantity)
+	notionalPrice := order.Price
+	if isMarket {
+		notional = order.AveragePrice.Mul(order.Quantity)
+		notionalPrice = order.AveragePrice
+		if !order.QuoteOrderQty.IsZero() {
+			notional = order.QuoteOrderQty
+		}
+	}
+
+	if filters.MIN_NOTIONAL != nil && !notional.IsZero() && (!isMarket || filters.MIN_NOTIONAL.ApplyToMarket) {
+		filter := filters.MIN_NOTIONAL
+		minNotional := validator.parse(filter.MinNotional)
+		if !minNotional.IsZero() && notional.LessThan(minNotional) {
+			validator.notionalViolation(spotSymbol, filter.FilterType, "minNotional", order, notional, notionalPrice, minNotional, true)
+		}
+	}
+
+	if filters.NOTIONAL != nil && !notional.IsZero() {
+		filter := filters.NOTIONAL
+		minNotional := validator.parse(filter.MinNotional)
+		maxNotional := validator.parse(filter.MaxNotional)
+		if (!isMarket || filter.ApplyMinToMarket) && !minNotional.IsZero() && notional.LessThan(minNotional) {
+			validator.notionalViolation(spotSymbol, filter.FilterType, "minNotional", order, notional, notionalPrice, minNotional, true)
+		}
+		if (!isMarket || filter.ApplyMaxToMarket) && !maxNotional.IsZero() && notional.GreaterThan(maxNotional) {
+			validator.notionalViolation(spotSymbol, filter.FilterType, "maxNotional", order, notional, notionalPrice, maxNotional, false)
+		}
+	}
+
+	if filters.ICEBERG_PARTS != nil && !order.IcebergQty.IsZero() && !order.Quantity.IsZero() {
+		filter := filters.ICEBERG_PARTS
+		parts, _ := order.Quantity.Div(order.IcebergQty, 0, ROUND_CEIL)
+		if filter.Limit != 0 && parts.GreaterThan(NewDecimal(filter.Limit, 0)) {
+			var step Decimal
+			if filters.LOT_SIZE != nil {
+				step = filters.LOT_SIZE.StepSize
+			}
+			suggestion := divToStep(order.Quantity, NewDecimal(filter.Limit, 0), step, ROUND_CEIL)
+			validator.suggest(filter.FilterType, "limit", "icebergQty", order.IcebergQty, suggestion, fmt.Sprintf("the iceberg order would be split in %v parts, the limit is %d", parts, filter.Limit))
+		}
+	}
+
+	if filters.MAX_NUM_ORDERS != nil {
+		validator.checkOpenOrders(filters.MAX_NUM_ORDERS.FilterType, order.OpenOrders, filters.MAX_NUM_ORDERS.MaxNumOrders)
+	}
+	if filters.MAX_NUM_ALGO_ORDERS != nil && spotIsAlgoType(order.Type) {
+		validator.checkOpenOrders(filters.MAX_NUM_ALGO_ORDERS.FilterType, order.OpenAlgoOrders, filters.MAX_NUM_ALGO_ORDERS.MaxNumAlgoOrders)
+	}
+	if filters.MAX_NUM_ICEBERG_ORDERS != nil && !order.IcebergQty.IsZero() {
+		validator.checkOpenOrders(filters.MAX_NUM_ICEBERG_ORDERS.FilterType, order.OpenIcebergOrders, filters.MAX_NUM_ICEBERG_ORDERS.MaxNumIcebergOrders)
+	}
+
+	if filters.MAX_POSITION != nil && order.Side == SPOT_Constants.OrderSides.BUY && !order.Quantity.IsZero() {
+		filter := filters.MAX_POSITION
+		maxPosition := validator.parse(filter.MaxPosition)
+		position := order.Position.Add(order.Quantity)
+		if !maxPosition.IsZero() && position.GreaterThan(maxPosition) {
+			suggestion := maxPosition.Sub(order.Position)
+			if suggestion.Sign() < 0 {
+				suggestion = Decimal{}
+			}
+			if filters.LOT_SIZE != nil {
+				suggestion = suggestion.RoundToStep(filters.LOT_SIZE.StepSize, ROUND_FLOOR)
+			}
+			validator.suggest(filter.FilterType, "maxPosition", "quantity", order.Quantity, suggestion, fmt.Sprintf("the position would reach %v, above maxPosition %s", position, filter.MaxPosition))
+		}
+	}
+
+	if filters.TRAILING_DELTA != nil && order.TrailingDelta != 0 {
+		filter := filters.TRAILING_DELTA
+		minDelta, maxDelta := filter.MinTrailingBelowDelta, filter.MaxTrailingBelowDelta
+		if spotIsTrailingAbove(order.Type, order.Side) {
+			minDelta, maxDelta = filter.MinTrailingAboveDelta, filter.MaxTrailingAboveDelta
+		}
+
+		if minDelta != 0 && order.TrailingDelta < minDelta {
+			validator.suggest(filter.FilterType, "minTrailingDelta", "trailingDelta", NewDecimal(order.TrailingDelta, 0), NewDecimal(minDelta, 0), fmt.Sprintf("trailingDelta %d is below %d", order.TrailingDelta, minDelta))
+		} else if maxDelta != 0 && order.TrailingDelta > maxDelta {
+			validator.suggest(filter.FilterType, "maxTrailingDelta", "trailingDelta", NewDecimal(order.TrailingDelta, 0), NewDecimal(maxDelta, 0), fmt.Sprintf("trailingDelta %d is above %d", order.TrailingDelta, maxDelta))
+		}
+	}
+
+	if validator.err != nil {
+		return nil, validator.err
+	}
+	return validator.violations, nil
+}
+
+// STOP_LOSS(_LIMIT) BUY and TAKE_PROFIT(_LIMIT) SELL orders trail above the price
+func spotIsTrailingAbove(orderType string, side string) bool {
+	isStopLoss := orderType == SPOT_Constants.OrderTypes.STOP_LOSS || orderType == SPOT_Constants.OrderTypes.STOP_LOSS_LIMIT
+	if side == SPOT_Constants.OrderSides.BUY {
+		return isStopLoss
+	}
+	return !isStopLoss
+}
+
+// The suggestion is rounded inside the bound to a multiple of "tickSize", unless it is 0
+func (validator *orderValidator) checkPercentPrice(filterType string, price Decimal, referencePrice Decimal, multiplierUpStr string, multiplierDownStr string, tickSize Decimal) {
+	multiplierUp := validator.parse(multiplierUpStr)
+	multiplierDown := validator.parse(multiplierDownStr)
+
+	upperBound := referencePrice.Mul(multiplierUp)
+	lowerBound := referencePrice.Mul(multiplierDown)
+
+	if !multiplierUp.IsZero() && price.GreaterThan(upperBound) {
+		validator.suggest(filterType, "multiplierUp", "price", price, upperBound.RoundToStep(tickSize, ROUND_FLOOR), fmt.Sprintf("price %v is above %v (%v x %s)", price, upperBound, referencePrice, multiplierUpStr))
+	} else if !multiplierDown.IsZero() && price.LessThan(lowerBound) {
+		validator.suggest(filterType, "multiplierDown", "price", price, lowerBound.RoundToStep(tickSize, ROUND_CEIL), fmt.Sprintf("price %v is below %v (%v x %s)", price, lowerBound, referencePrice, multiplierDownStr))
+	}
+}
+
+// Suggests the quantity (or quote quantity) reaching the notional bound
+func (validator *orderValidator) notionalViolation(spotSymbol *Spot_Symbol, filterType string, reason string, order Spot_ValidateOrder_Params, notional Decimal, notionalPrice Decimal, bound Decimal, isMin bool) {
+	message := fmt.Sprintf("notional %v is below %s %v", notional, reason, bound)
+	if !isMin {
+		message = fmt.Sprintf("notional %v is above %s %v", notional, reason, bound)
+	}
+
+	if !order.QuoteOrderQty.IsZero() {
+		validator.suggest(filterType, reason, "quoteOrderQty", order.QuoteOrderQty, bound, message)
+		return
+	}
+
+	var step Decimal
+	if spotSymbol.Filters.LOT_SIZE != nil {
+		step = spotSymbol.Filters.LOT_SIZE.StepSize
+	}
+
+	mode := ROUND_FLOOR
+	if isMin {
+		mode = ROUND_CEIL
+	}
+	suggestion := divToStep(bound, notionalPrice, step, mode)
+	validator.suggest(filterType, reason, "quantity", order.Quantity, suggestion, message)
+}
+
+// # Checks the order against every filter of the symbol and the exchange filters
+//
+// Returns a DATA_NOT_FOUND_ERR if the symbol is not in the exchange info.
+func (exchangeInfo *Spot_ExchangeInfo) ValidateOrder(symbol string, order Spot_ValidateOrder_Params) (violations []*OrderViolation, err *Error) {
+	var spotSymbol *Spot_Symbol
+	for _, symbol_obj := range exchangeInfo.Symbols_arr {
+		if symbol_obj.Symbol == symbol {
+			spotSymbol = symbol_obj
+			break
+		}
+	}
+	if spotSymbol == nil {
+		return nil, LocalError(DATA_NOT_FOUND_ERR, fmt.Sprintf("Symbol '%s' was not found in the exchange info", symbol))
+	}
+
+	violations, err = spotSymbol.ValidateOrder(order)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := &orderValidator{violations: violations}
+	if exchangeFilters := exchangeInfo.ExchangeFilters; exchangeFilters != nil {
+		if exchangeFilters.EXCHANGE_MAX_NUM_ORDERS != nil {
+			validator.checkOpenOrders(exchangeFilters.EXCHANGE_MAX_NUM_ORDERS.FilterType, order.AccountOpenOrders, exchangeFilters.EXCHANGE_MAX_NUM_ORDERS.MaxNumOrders)
+		}
+		if exchangeFilters.EXCHANGE_MAX_NUM_ALGO_ORDERS != nil && spotIsAlgoType(order.Type) {
+			validator.checkOpenOrders(exchangeFilters.EXCHANGE_MAX_NUM_ALGO_ORDERS.FilterType, order.AccountOpenAlgoOrders, exchangeFilters.EXCHANGE_MAX_NUM_ALGO_ORDERS.MaxNumAlgoOrders)
+		}
+		if exchangeFilters.EXCHANGE_MAX_NUM_ICEBERG_ORDERS != nil && !order.IcebergQty.IsZero() {
+			validator.checkOpenOrders(exchangeFilters.EXCHANGE_MAX_NUM_ICEBERG_ORDERS.FilterType, order.AccountOpenIcebergOrders, exchangeFilters.EXCHANGE_MAX_NUM_ICEBERG_ORDERS.MaxNumIcebergOrders)
+		}
+	}
+
+	return validator.violations, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Futures_ValidateOrder_Params struct {
+	// One of FUTURES_Constants.OrderSides
+	Side string
+	// One of FUTURES_Constants.OrderTypes
+	Type string
+
+	// Limit price, not used by MARKET, STOP_MARKET, TAKE_PROFIT_MARKET and TRAILING_STOP_MARKET orders
+	Price      Decimal
+	Quantity   Decimal
+	StopPrice  Decimal
+	ReduceOnly bool
+
+	// Mark price of the symbol, used by PERCENT_PRICE, and by MIN_NOTIONAL for market orders
+	//
+	// These checks are skipped if it is 0
+	MarkPrice Decimal
+
+	// Open orders on the symbol, for MAX_NUM_ORDERS and MAX_NUM_ALGO_ORDERS
+	OpenOrders     int64
+	OpenAlgoOrders int64
+}
+
+func futuresIsMarketType(orderType string) bool {
+	return orderType == FUTURES_Constants.OrderTypes.MARKET || orderType == FUTURES_Constants.OrderTypes.STOP_MARKET || orderType == FUTURES_Constants.OrderTypes.TAKE_PROFIT_MARKET || orderType == FUTURES_Constants.OrderTypes.TRAILING_STOP_MARKET
+}
+
+func futuresIsAlgoType(orderType string) bool {
+	return orderType != FUTURES_Constants.OrderTypes.LIMIT && orderType != FUTURES_Constants.OrderTypes.MARKET
+}
+
+// # Checks the order against every filter of the symbol
+//
+// Returns every violation found (nil if the order passes), each with the field to correct and a suggested value when one can be computed.
+func (futuresSymbol *Futures_Symbol) ValidateOrder(order Futures_ValidateOrder_Params) (violations []*OrderViolation, err *Error) {
+	return validateFuturesOrder(&futuresSymbol.Filters, order)
+}
+
+// # Checks the order against every filter of the symbol
+//
+// Quantities are expressed in contracts, so MIN_NOTIONAL is not checked.
+func (deliverySymbol *Delivery_Symbol) ValidateOrder(order Futures_ValidateOrder_Params) (violations []*OrderViolation, err *Error) {
+	filters := deliverySymbol.Filters
+	filters.MIN_NOTIONAL = nil
+
+	return validateFuturesOrder(&filters, order)
+}
+
+func validateFuturesOrder(filters *Futures_SymbolFilters, order Futures_ValidateOrder_Params) (violations []*OrderViolation, err *Error) {
+	validator := &orderValidator{}
+	isMarket := futuresIsMarketType(order.Type)
+
+	var tickSize Decimal
+	if filters.PRICE_FILTER != nil {
+		filter := filters.PRICE_FILTER
+		tickSize = filter.TickSize
+		if !isMarket && !order.Price.IsZero() {
+			validator.checkRange(filter.FilterType, "price", order.Price, filter.MinPrice, filter.MaxPrice, filter.TickSize, "minPrice", "maxPrice", "tickSize")
+		}
+		if !order.StopPrice.IsZero() {
+			validator.checkRange(filter.FilterType, "stopPrice", order.StopPrice, filter.MinPrice, filter.MaxPrice, filter.TickSize, "minPrice", "maxPrice", "tickSize")
+		}
+	}
+
+	if filters.LOT_SIZE != nil && !isMarket && !order.Quantity.IsZero() {
+		filter := filters.LOT_SIZE
+		validator.checkRange(filter.FilterType, "quantity", order.Quantity, filter.MinQty, filter.MaxQty, filter.StepSize, "minQty", "maxQty", "stepSize")
+	}
+
+	if filters.MARKET_LOT_SIZE != nil && isMarket && !order.Quantity.IsZero() {
+		filter := filters.MARKET_LOT_SIZE
+		validator.checkRange(filter.FilterType, "quantity", order.Quantity, filter.MinQty, filter.MaxQty, filter.StepSize, "minQty", "maxQty", "stepSize")
+	}
+
+	if filters.PERCENT_PRICE != nil && !isMarket && !order.Price.IsZero() && !order.MarkPrice.IsZero() {
+		filter := filters.PERCENT_PRICE
+		validator.checkPercentPrice(filter.FilterType, order.Price, order.MarkPrice, filter.MultiplierUp, filter.MultiplierDown, tickSize)
+	}
+
+	if filters.MIN_NOTIONAL != nil && !order.ReduceOnly && !order.Quantity.IsZero() {
+		filter := filters.MIN_NOTIONAL
+		price := order.Price
+		if isMarket {
+			price = order.MarkPrice
+		}
+
+		minNotional := validator.parse(filter.Notional)
+		notional := price.Mul(order.Quantity)
+		if !price.IsZero() && !minNotional.IsZero() && notional.LessThan(minNotional) {
+			var step Decimal
+			if filters.LOT_SIZE != nil {
+				step = filters.LOT_SIZE.StepSize
+			}
+			suggestion := divToStep(minNotional, price, step, ROUND_CEIL)
+			validator.suggest(filter.FilterType, "notional", "quantity", order.Quantity, suggestion, fmt.Sprintf("notional %v is below %s", notional, filter.Notional))
+		}
+	}
+
+	if filters.MAX_NUM_ORDERS != nil {
+		validator.checkOpenOrders(filters.MAX_NUM_ORDERS.FilterType, order.OpenOrders, filters.MAX_NUM_ORDERS.Limit)
+	}
+	if filters.MAX_NUM_ALGO_ORDERS != nil && futuresIsAlgoType(order.Type) {
+		validator.checkOpenOrders(filters.MAX_NUM_ALGO_ORDERS.FilterType, order.OpenAlgoOrders, filters.MAX_NUM_ALGO_ORDERS.Limit)
+	}
+
+	if validator.err != nil {
+		return nil, validator.err
+	}
+	return validator.violations, nil
+}
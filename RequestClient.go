@@ -7,10 +7,13 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
+	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -19,6 +22,9 @@ type RequestClient struct {
 
 	client *http.Client
 	api    APIKEYS
+
+	// Overrides the client's Opts.paramsPlacement when set, see Binance.WithParamsPlacement()
+	paramsPlacement string
 }
 
 type Response struct {
@@ -108,6 +114,32 @@ func (resp *Response) GetUsedWeight(interval string) (int64, *Error) {
 		key += "-" + interval
 	}
 
+	return resp.getWeightHeader(key)
+}
+
+// # Fetches the current used SAPI IP weight returned by a /sapi request.
+//
+// SAPI endpoints are limited either by IP or by UID (account), see WALLET_Constants.RateLimits
+//
+// interval: "1m" is the only one used as of writing this
+//
+// Returns an error if the header is not found (i.e: the endpoint is UID limited)
+func (resp *Response) GetSAPIUsedIPWeight(interval string) (int64, *Error) {
+	return resp.getWeightHeader("X-Sapi-Used-Ip-Weight-" + interval)
+}
+
+// # Fetches the current used SAPI UID weight returned by a /sapi request.
+//
+// SAPI endpoints are limited either by IP or by UID (account), see WALLET_Constants.RateLimits
+//
+// interval: "1m" is the only one used as of writing this
+//
+// Returns an error if the header is not found (i.e: the endpoint is IP limited)
+func (resp *Response) GetSAPIUsedUIDWeight(interval string) (int64, *Error) {
+	return resp.getWeightHeader("X-Sapi-Used-Uid-Weight-" + interval)
+}
+
+func (resp *Response) getWeightHeader(key string) (int64, *Error) {
 	strValue := resp.Header.Get(key)
 
 	if strValue == "" {
@@ -232,6 +264,71 @@ func (requestClient *RequestClient) init(binance *Binance) {
 	requestClient.client = &http.Client{}
 }
 
+// The clients of a ClientPool share the pool's http.Client
+func (requestClient *RequestClient) httpClient() *http.Client {
+	if requestClient.binance.pool != nil {
+		return requestClient.binance.pool.httpClient
+	}
+	return requestClient.client
+}
+
+// Waits for the account's limits and the pool's IP limits, if the client is part of a ClientPool
+//
+// The request counts against the limits until "release" is called, once its response's usage is updated
+func (requestClient *RequestClient) reserveLimits(method string, baseURL string, URL string) (release func()) {
+	if requestClient.binance.pool == nil {
+		return func() {}
+	}
+
+	isOrder := isOrderEndpoint(method, URL)
+	accountLimits := requestClient.binance.accountLimits
+	ipLimits := requestClient.binance.pool.ipLimits
+
+	// The account's limits first, so that an account waiting on its own limits doesn't hold a share of the IP limits
+	accountLimits.reserve(baseURL, isOrder)
+	ipLimits.reserve(baseURL, isOrder)
+
+	return func() {
+		ipLimits.release(baseURL, isOrder)
+		accountLimits.release(baseURL, isOrder)
+	}
+}
+
+// Whether the request counts towards the order count limits: placing, modifying or replacing orders (i.e: "/api/v3/order", "/fapi/v1/batchOrders", "/sapi/v1/margin/order")
+//
+// Other POST and PUT requests (listenKeys, transfers, sub-accounts...) don't count.
+func isOrderEndpoint(method string, URL string) bool {
+	if method != Constants.Methods.POST && method != Constants.Methods.PUT {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(URL), "order")
+}
+
+func (requestClient *RequestClient) updateLimits(baseURL string, resp *Response) {
+	if requestClient.binance.pool == nil {
+		return
+	}
+
+	requestClient.binance.pool.ipLimits.update(baseURL, resp)
+	requestClient.binance.accountLimits.update(baseURL, resp)
+}
+
+// Returns the credentials of the client's CredentialsProvider, or the keys it was created with if none is set
+func (requestClient *RequestClient) credentials() (KEY string, SECRET string, Err *Error) {
+	provider := requestClient.binance.credentialsProvider()
+	if provider == nil {
+		return requestClient.api.KEY, requestClient.api.SECRET, nil
+	}
+
+	KEY, SECRET, err := provider.Credentials()
+	if err != nil {
+		return "", "", LocalError(CREDENTIALS_ERR, err.Error())
+	}
+
+	return KEY, SECRET, nil
+}
+
 func (requestClient *RequestClient) Set_APIKEY(APIKEY string, APISECRET string) {
 	requestClient.api.KEY = APIKEY
 	requestClient.api.SECRET = APISECRET
@@ -265,76 +362,275 @@ func readResponseBody(rawResponse *http.Response) (*Response, error) {
 	return &resp, nil
 }
 
+// Splits the encoded parameters between the query string and the body, following the request's Constants.ParamsPlacements
+func (requestClient *RequestClient) placeParams(method string, paramString string) (queryString string, bodyString string) {
+	placement := requestClient.paramsPlacement
+	if placement == "" {
+		placement = requestClient.binance.Opts.paramsPlacement
+	}
+
+	if method == Constants.Methods.GET || placement == Constants.ParamsPlacements.QUERY {
+		return paramString, ""
+	}
+
+	return "", paramString
+}
+
+// Parameters in the body are sent as application/x-www-form-urlencoded
+func newHTTPRequest(method string, endpoint string, queryString string, bodyString string) (*http.Request, error) {
+	if bodyString == "" {
+		return http.NewRequest(method, endpoint+"?"+queryString, nil)
+	}
+
+	req, err := http.NewRequest(method, endpoint+"?"+queryString, strings.NewReader(bodyString))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req, nil
+}
+
+// The signature covers the query string concatenated with the body, with no separator in between
+func sign(SECRET string, queryString string, bodyString string) (string, error) {
+	h := hmac.New(sha256.New, []byte(SECRET))
+	_, err := h.Write([]byte(queryString + bodyString))
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Used for the logs
+func describeRequest(endpoint string, queryString string, bodyString string) string {
+	description := endpoint + "?" + queryString
+	if bodyString != "" {
+		description += " BODY: " + bodyString
+	}
+
+	return description
+}
+
+// # Request parameters encoded in the order they are given
+//
+// For the requests that rely on a specific order, a map[string]interface{} has none and is encoded in alphabetical order instead.
+type Request_OrderedParams []Request_Param
+
+type Request_Param struct {
+	Key   string
+	Value interface{}
+}
+
+// Returns the parameters in alphabetical order, the order they are encoded in when passed as a map
+func sortedParams(params map[string]interface{}) Request_OrderedParams {
+	orderedParams := make(Request_OrderedParams, 0, len(params))
+	for _, key := range sortedKeys(params) {
+		orderedParams = append(orderedParams, Request_Param{Key: key, Value: params[key]})
+	}
+
+	return orderedParams
+}
+
+func (params Request_OrderedParams) has(key string) bool {
+	for _, param := range params {
+		if param.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
 // createQueryString transforms a map[string]interface{} into a query string
-func createQueryString(params map[string]interface{}, sorted bool) string {
-	if params == nil {
-		return ""
+//
+// Keys are encoded in alphabetical order, so that the same parameters always produce the same string (and signature),
+// use a Request_OrderedParams and encodeParams() to choose the order.
+func createQueryString(params map[string]interface{}) (string, error) {
+	return encodeParams(sortedParams(params))
+}
+
+// encodeParams transforms the parameters into a query string, in the order they are given
+//
+// Supported values:
+//
+// - strings, booleans, every integer and float width (floats never use scientific notation), and named types based on them
+//
+// - time.Time (as a unix timestamp in ms) and time.Duration (in ms)
+//
+// - decimal types implementing fmt.Stringer
+//
+// - []string, slices of structs or maps and structs are JSON-encoded (e.g: batchOrders)
+//
+// - []interface{} repeats the key for every item
+//
+// - map[string]interface{} (in alphabetical order) and Request_OrderedParams (in their order) use the "key.subKey" notation
+//
+// - pointers are dereferenced, nil values are skipped
+//
+// Any other value returns an error.
+func encodeParams(params Request_OrderedParams) (string, error) {
+	var builder strings.Builder
+
+	for _, param := range params {
+		err := encodeParam(&builder, param.Key, param.Value)
+		if err != nil {
+			return "", err
+		}
 	}
 
-	// Extract keys to sort them if `sorted` is true
+	return builder.String(), nil
+}
+
+func sortedKeys(params map[string]interface{}) []string {
 	keys := make([]string, 0, len(params))
 	for key := range params {
 		keys = append(keys, key)
 	}
+	sort.Strings(keys)
 
-	if sorted {
-		sort.Strings(keys)
-	}
+	return keys
+}
 
-	query := url.Values{}
+func addParam(builder *strings.Builder, key string, value string) {
+	if builder.Len() != 0 {
+		builder.WriteByte('&')
+	}
+	builder.WriteString(url.QueryEscape(key))
+	builder.WriteByte('=')
+	builder.WriteString(url.QueryEscape(value))
+}
 
-	// Helper function to process values
-	var addToQuery func(key string, value interface{})
-	addToQuery = func(key string, value interface{}) {
-		switch v := value.(type) {
-		case string:
-			query.Add(key, v)
-		case []string:
-			// Encode slices as JSON arrays
-			jsonValue, err := json.Marshal(v)
+func encodeParam(builder *strings.Builder, key string, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		addParam(builder, key, v)
+	case bool:
+		addParam(builder, key, strconv.FormatBool(v))
+	case int:
+		addParam(builder, key, strconv.FormatInt(int64(v), 10))
+	case int8:
+		addParam(builder, key, strconv.FormatInt(int64(v), 10))
+	case int16:
+		addParam(builder, key, strconv.FormatInt(int64(v), 10))
+	case int32:
+		addParam(builder, key, strconv.FormatInt(int64(v), 10))
+	case int64:
+		addParam(builder, key, strconv.FormatInt(v, 10))
+	case uint:
+		addParam(builder, key, strconv.FormatUint(uint64(v), 10))
+	case uint8:
+		addParam(builder, key, strconv.FormatUint(uint64(v), 10))
+	case uint16:
+		addParam(builder, key, strconv.FormatUint(uint64(v), 10))
+	case uint32:
+		addParam(builder, key, strconv.FormatUint(uint64(v), 10))
+	case uint64:
+		addParam(builder, key, strconv.FormatUint(v, 10))
+	case float32:
+		return encodeFloat(builder, key, float64(v), 32)
+	case float64:
+		return encodeFloat(builder, key, v, 64)
+	case time.Time:
+		addParam(builder, key, strconv.FormatInt(v.UnixMilli(), 10))
+	case time.Duration:
+		addParam(builder, key, strconv.FormatInt(v.Milliseconds(), 10))
+	case []interface{}:
+		for _, item := range v {
+			err := encodeParam(builder, key, item)
 			if err != nil {
-				LOG_ERRORS(fmt.Sprintf("[VERBOSE] Error marshaling slice for key %s: %v\n", key, err))
-				return
-			}
-			query.Add(key, string(jsonValue)) // Add JSON-encoded array
-		case []interface{}:
-			for _, item := range v {
-				addToQuery(key, item) // Recursively handle each item
+				return err
 			}
-		case map[string]interface{}:
-			// Handle nested maps with dot notation
-			for subKey, subValue := range v {
-				addToQuery(key+"."+subKey, subValue)
+		}
+	case map[string]interface{}:
+		return encodeParam(builder, key, sortedParams(v))
+	case Request_OrderedParams:
+		for _, param := range v {
+			err := encodeParam(builder, key+"."+param.Key, param.Value)
+			if err != nil {
+				return err
 			}
-		case int, int64, float64, bool: // Convert basic types to string
-			query.Add(key, fmt.Sprintf("%v", v))
-		default:
-			LOG_ERRORS("[VERBOSE] Error adding parameter, invalid type detected: received:", v)
 		}
+	case fmt.Stringer:
+		if reflected := reflect.ValueOf(v); reflected.Kind() == reflect.Pointer && reflected.IsNil() {
+			return nil
+		}
+		addParam(builder, key, v.String())
+	default:
+		return encodeReflectedParam(builder, key, reflect.ValueOf(value))
+	}
+
+	return nil
+}
+
+func encodeFloat(builder *strings.Builder, key string, value float64, bitSize int) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("parameter '%s' cannot be encoded: %v is not a finite number", key, value)
 	}
 
-	// Process each key-value pair
-	for _, key := range keys {
-		addToQuery(key, params[key])
+	addParam(builder, key, strconv.FormatFloat(value, 'f', -1, bitSize))
+	return nil
+}
+
+// Handles pointers, named types and the JSON-encoded values
+func encodeReflectedParam(builder *strings.Builder, key string, value reflect.Value) error {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return encodeParam(builder, key, value.Elem().Interface())
+	case reflect.String:
+		addParam(builder, key, value.String())
+	case reflect.Bool:
+		addParam(builder, key, strconv.FormatBool(value.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		addParam(builder, key, strconv.FormatInt(value.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		addParam(builder, key, strconv.FormatUint(value.Uint(), 10))
+	case reflect.Float32:
+		return encodeFloat(builder, key, value.Float(), 32)
+	case reflect.Float64:
+		return encodeFloat(builder, key, value.Float(), 64)
+	case reflect.Slice, reflect.Array, reflect.Struct, reflect.Map:
+		jsonValue, err := json.Marshal(value.Interface())
+		if err != nil {
+			return fmt.Errorf("parameter '%s' cannot be JSON-encoded: %s", key, err.Error())
+		}
+		addParam(builder, key, string(jsonValue))
+	default:
+		return fmt.Errorf("parameter '%s' cannot be encoded: unsupported type %T", key, value.Interface())
 	}
 
-	return query.Encode()
+	return nil
 }
 
 //
 
 func (requestClient *RequestClient) Unsigned(method string, baseURL string, URL string, params map[string]interface{}) (*Response, *Error) {
+	return requestClient.Unsigned_Ordered(method, baseURL, URL, sortedParams(params))
+}
+
+// Same as Unsigned(), with the parameters encoded in the order they are given
+func (requestClient *RequestClient) Unsigned_Ordered(method string, baseURL string, URL string, params Request_OrderedParams) (*Response, *Error) {
 	var err error
 	var rawResponse *http.Response
 
-	paramString := createQueryString(params, false)
+	paramString, encodingErr := encodeParams(params)
+	if encodingErr != nil {
+		return nil, LocalError(PARAMS_ENCODING_ERR, encodingErr.Error())
+	}
 
 	fullQuery := baseURL + URL + "?" + paramString
 
+	release := requestClient.reserveLimits(method, baseURL, URL)
+	defer release()
+
 	startTime := time.Now().UnixMilli()
 	switch method {
 	case Constants.Methods.GET:
-		rawResponse, err = http.Get(fullQuery)
+		rawResponse, err = requestClient.httpClient().Get(fullQuery)
 
 	default:
 		panic(fmt.Sprintf("Method passed to Unsigned Request function is invalid, received: '%s'\nSupported methods are ('%s', '%s', '%s', '%s', '%s')", method, Constants.Methods.GET, Constants.Methods.POST, Constants.Methods.PUT, Constants.Methods.PATCH, Constants.Methods.DELETE))
@@ -353,6 +649,8 @@ func (requestClient *RequestClient) Unsigned(method string, baseURL string, URL
 	}
 	resp.Latency = latency
 
+	requestClient.updateLimits(baseURL, resp)
+
 	LOG_HTTP_QUERIES(fmt.Sprintf("%s %s: %s\n", resp.Request.Method, resp.Status, fullQuery))
 	LOG_HTTP_RESPONSES(fmt.Sprintf("%s %s: %s =>\nResponse: %s\n", resp.Request.Method, resp.Status, fullQuery, string(resp.Body)))
 
@@ -370,19 +668,35 @@ func (requestClient *RequestClient) Unsigned(method string, baseURL string, URL
 }
 
 func (requestClient *RequestClient) APIKEY_only(method string, baseURL string, URL string, params map[string]interface{}) (*Response, *Error) {
+	return requestClient.APIKEY_only_Ordered(method, baseURL, URL, sortedParams(params))
+}
 
-	paramString := createQueryString(params, false)
+// Same as APIKEY_only(), with the parameters encoded in the order they are given
+func (requestClient *RequestClient) APIKEY_only_Ordered(method string, baseURL string, URL string, params Request_OrderedParams) (*Response, *Error) {
 
-	fullQuery := baseURL + URL + "?" + paramString
+	paramString, encodingErr := encodeParams(params)
+	if encodingErr != nil {
+		return nil, LocalError(PARAMS_ENCODING_ERR, encodingErr.Error())
+	}
+	queryString, bodyString := requestClient.placeParams(method, paramString)
 
-	req, err := http.NewRequest(method, fullQuery, nil)
+	fullQuery := describeRequest(baseURL+URL, queryString, bodyString)
+
+	req, err := newHTTPRequest(method, baseURL+URL, queryString, bodyString)
 	if err != nil {
 		return nil, LocalError(HTTP_REQUEST_ERR, err.Error())
 	}
 
-	req.Header.Set("X-MBX-APIKEY", requestClient.api.KEY)
+	KEY, _, Err := requestClient.credentials()
+	if Err != nil {
+		return nil, Err
+	}
+	req.Header.Set("X-MBX-APIKEY", KEY)
+
+	release := requestClient.reserveLimits(method, baseURL, URL)
+	defer release()
 
-	rawResponse, err := requestClient.client.Do(req)
+	rawResponse, err := requestClient.httpClient().Do(req)
 	if err != nil {
 		LOG_ERRORS("[VERBOSE] Request error:", err)
 		Err := Error{
@@ -405,6 +719,8 @@ func (requestClient *RequestClient) APIKEY_only(method string, baseURL string, U
 		return nil, &Err
 	}
 
+	requestClient.updateLimits(baseURL, resp)
+
 	LOG_HTTP_QUERIES(fmt.Sprintf("%s %s: %s\n", resp.Request.Method, resp.Status, fullQuery))
 	LOG_HTTP_RESPONSES(fmt.Sprintf("%s %s: %s =>\nResponse: %s\n", resp.Request.Method, resp.Status, fullQuery, string(resp.Body)))
 
@@ -429,27 +745,63 @@ func (requestClient *RequestClient) Signed(method string, baseURL string, URL st
 		params["recvWindow"] = requestClient.binance.Opts.recvWindow
 	}
 
-	paramString := createQueryString(params, false)
+	return requestClient.signed(method, baseURL, URL, sortedParams(params))
+}
+
+// Same as Signed(), with the parameters encoded in the order they are given, followed by the timestamp and recvWindow
+func (requestClient *RequestClient) Signed_Ordered(method string, baseURL string, URL string, params Request_OrderedParams) (*Response, *Error) {
+	params = append(params[:len(params):len(params)], Request_Param{Key: "timestamp", Value: time.Now().UnixMilli() + requestClient.binance.configs.timestamp_offset})
 
-	h := hmac.New(sha256.New, []byte(requestClient.api.SECRET))
-	_, err := h.Write([]byte(paramString))
+	if requestClient.binance.Opts.recvWindow != 5000 && !params.has("recvWindow") {
+		params = append(params, Request_Param{Key: "recvWindow", Value: requestClient.binance.Opts.recvWindow})
+	}
+
+	return requestClient.signed(method, baseURL, URL, params)
+}
+
+// Signs the parameters as they are, the timestamp and recvWindow must already be set
+func (requestClient *RequestClient) signed(method string, baseURL string, URL string, params Request_OrderedParams) (*Response, *Error) {
+	KEY, SECRET, Err := requestClient.credentials()
+	if Err != nil {
+		return nil, Err
+	}
+
+	paramString, encodingErr := encodeParams(params)
+	if encodingErr != nil {
+		return nil, LocalError(PARAMS_ENCODING_ERR, encodingErr.Error())
+	}
+	queryString, bodyString := requestClient.placeParams(method, paramString)
+
+	signature, err := sign(SECRET, queryString, bodyString)
 	if err != nil {
 		return nil, LocalError(HTTP_SIGNATURE_ERR, err.Error())
 	}
 
-	signature := hex.EncodeToString(h.Sum(nil))
-
-	fullQuery := baseURL + URL + "?" + paramString + "&signature=" + signature
+	// The signature is kept out of the logs and errors
+	var loggedQuery string
+	if bodyString != "" {
+		loggedQuery = describeRequest(baseURL+URL, queryString, bodyString+"&signature=REDACTED")
+		bodyString += "&signature=" + signature
+	} else {
+		loggedQuery = describeRequest(baseURL+URL, queryString+"&signature=REDACTED", bodyString)
+		queryString += "&signature=" + signature
+	}
 
-	req, err := http.NewRequest(method, fullQuery, nil)
+	req, err := newHTTPRequest(method, baseURL+URL, queryString, bodyString)
 	if err != nil {
 		return nil, LocalError(HTTP_REQUEST_ERR, err.Error())
 	}
 
-	req.Header.Set("X-MBX-APIKEY", requestClient.api.KEY)
+	req.Header.Set("X-MBX-APIKEY", KEY)
+
+	release := requestClient.reserveLimits(method, baseURL, URL)
+	defer release()
 
-	rawResponse, err := requestClient.client.Do(req)
+	rawResponse, err := requestClient.httpClient().Do(req)
 	if err != nil {
+		if urlErr, ok := err.(*url.Error); ok {
+			urlErr.URL = loggedQuery
+		}
 		Err := Error{
 			IsLocalError: true,
 			Code:         HTTP_REQUEST_ERR,
@@ -471,8 +823,10 @@ func (requestClient *RequestClient) Signed(method string, baseURL string, URL st
 		return nil, &Err
 	}
 
-	LOG_HTTP_RESPONSES(fmt.Sprintf("%s %s: %s =>\nResponse: %s\n", resp.Request.Method, resp.Status, fullQuery, string(resp.Body)))
-	LOG_HTTP_QUERIES(fmt.Sprintf("%s %s: %s\n", resp.Request.Method, resp.Status, fullQuery))
+	requestClient.updateLimits(baseURL, resp)
+
+	LOG_HTTP_RESPONSES(fmt.Sprintf("%s %s: %s =>\nResponse: %s\n", resp.Request.Method, resp.Status, loggedQuery, string(resp.Body)))
+	LOG_HTTP_QUERIES(fmt.Sprintf("%s %s: %s\n", resp.Request.Method, resp.Status, loggedQuery))
 
 	if resp.StatusCode >= 400 {
 		Err, UnmarshallErr := BinanceError(resp)
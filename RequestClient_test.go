@@ -0,0 +1,39 @@
+package Binance
+
+import "testing"
+
+func TestEncodeParamsKeepsTheGivenOrder(t *testing.T) {
+	queryString, err := encodeParams(Request_OrderedParams{
+		{Key: "symbol", Value: "BTCUSDT"},
+		{Key: "side", Value: "BUY"},
+		{Key: "quantity", Value: 0.001},
+		{Key: "filters", Value: Request_OrderedParams{{Key: "b", Value: 1}, {Key: "a", Value: 2}}},
+		{Key: "skipped", Value: nil},
+		{Key: "price", Value: "100"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "symbol=BTCUSDT&side=BUY&quantity=0.001&filters.b=1&filters.a=2&price=100"
+	if queryString != expected {
+		t.Fatalf("expected %q, got %q", expected, queryString)
+	}
+}
+
+func TestCreateQueryStringIsAlphabetical(t *testing.T) {
+	queryString, err := createQueryString(map[string]interface{}{
+		"symbol":   "BTCUSDT",
+		"side":     "BUY",
+		"quantity": 0.001,
+		"filters":  map[string]interface{}{"b": 1, "a": 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "filters.a=2&filters.b=1&quantity=0.001&side=BUY&symbol=BTCUSDT"
+	if queryString != expected {
+		t.Fatalf("expected %q, got %q", expected, queryString)
+	}
+}
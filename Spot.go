@@ -336,6 +336,187 @@ func (symbol *Spot_Symbol) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+/////////////////////////////////////////////////////////////////////////////////
+
+// Empty fields are not filtered on
+type Spot_SymbolQuery struct {
+	BaseAsset  string
+	QuoteAsset string
+	// One of SPOT_Constants.SymbolStatuses
+	Status string
+	// Every permission must be found in the symbol's "permissions" or in one of its "permissionSets"
+	Permissions []string
+	// Every order type must be supported by the symbol
+	OrderTypes []string
+	// Only returns symbols allowing spot trading
+	IsSpotTradingAllowed bool
+	// Only returns symbols allowing margin trading
+	IsMarginTradingAllowed bool
+}
+
+// # Returns the symbols matching every field of the query, in the exchange info's order
+func (exchangeInfo *Spot_ExchangeInfo) QuerySymbols(query Spot_SymbolQuery) []*Spot_Symbol {
+	symbols := make([]*Spot_Symbol, 0)
+
+	for _, symbol := range exchangeInfo.Symbols_arr {
+		if query.BaseAsset != "" && symbol.BaseAsset != query.BaseAsset {
+			continue
+		}
+		if query.QuoteAsset != "" && symbol.QuoteAsset != query.QuoteAsset {
+			continue
+		}
+		if query.Status != "" && symbol.Status != query.Status {
+			continue
+		}
+		if query.IsSpotTradingAllowed && !symbol.IsSpotTradingAllowed {
+			continue
+		}
+		if query.IsMarginTradingAllowed && !symbol.IsMarginTradingAllowed {
+			continue
+		}
+		if !containsAll(symbol.OrderTypes, query.OrderTypes) {
+			continue
+		}
+		if !symbol.HasPermissions(query.Permissions...) {
+			continue
+		}
+
+		symbols = append(symbols, symbol)
+	}
+
+	return symbols
+}
+
+// Returns true if every permission is found in the symbol's "permissions" or in one of its "permissionSets"
+func (symbol *Spot_Symbol) HasPermissions(permissions ...string) bool {
+	for _, permission := range permissions {
+		found := containsAll(symbol.Permissions, []string{permission})
+		for _, permissionSet := range symbol.PermissionSets {
+			if found {
+				break
+			}
+			found = containsAll(permissionSet, []string{permission})
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns the quote assets, sorted and without duplicates
+//
+// "status" (one of SPOT_Constants.SymbolStatuses) only counts the symbols with that status, all symbols are counted if omitted
+func (exchangeInfo *Spot_ExchangeInfo) QuoteAssets(status ...string) []string {
+	return exchangeInfo.assets(func(symbol *Spot_Symbol) string { return symbol.QuoteAsset }, status)
+}
+
+// Returns the base assets, sorted and without duplicates
+//
+// "status" (one of SPOT_Constants.SymbolStatuses) only counts the symbols with that status, all symbols are counted if omitted
+func (exchangeInfo *Spot_ExchangeInfo) BaseAssets(status ...string) []string {
+	return exchangeInfo.assets(func(symbol *Spot_Symbol) string { return symbol.BaseAsset }, status)
+}
+
+func (exchangeInfo *Spot_ExchangeInfo) assets(asset func(symbol *Spot_Symbol) string, status []string) []string {
+	assets := make([]string, 0)
+	for _, symbol := range exchangeInfo.Symbols_arr {
+		if len(status) != 0 && symbol.Status != status[0] {
+			continue
+		}
+		assets = append(assets, asset(symbol))
+	}
+
+	return uniqueSorted(assets)
+}
+
+type Spot_Route struct {
+	Legs []*Spot_RouteLeg
+}
+
+type Spot_RouteLeg struct {
+	Symbol *Spot_Symbol
+	// "SELL" when going from the base asset to the quote asset, "BUY" otherwise
+	Side      string
+	FromAsset string
+	ToAsset   string
+}
+
+type Spot_Routes_Params struct {
+	// Default 2, max 3
+	MaxLegs int
+	// Only "TRADING" symbols are used by default
+	IncludeNonTrading bool
+}
+
+// # Finds every route converting "fromAsset" into "toAsset"
+//
+// Routes are returned from the shortest to the longest, never going through the same asset twice.
+func (exchangeInfo *Spot_ExchangeInfo) Routes(fromAsset string, toAsset string, opt_params ...Spot_Routes_Params) []*Spot_Route {
+	maxLegs := 2
+	includeNonTrading := false
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.MaxLegs) {
+			maxLegs = min(params.MaxLegs, 3)
+		}
+		includeNonTrading = params.IncludeNonTrading
+	}
+
+	legsFrom := make(map[string][]*Spot_RouteLeg)
+	for _, symbol := range exchangeInfo.Symbols_arr {
+		if !includeNonTrading && symbol.Status != SPOT_Constants.SymbolStatuses.TRADING {
+			continue
+		}
+
+		legsFrom[symbol.BaseAsset] = append(legsFrom[symbol.BaseAsset], &Spot_RouteLeg{Symbol: symbol, Side: SPOT_Constants.OrderSides.SELL, FromAsset: symbol.BaseAsset, ToAsset: symbol.QuoteAsset})
+		legsFrom[symbol.QuoteAsset] = append(legsFrom[symbol.QuoteAsset], &Spot_RouteLeg{Symbol: symbol, Side: SPOT_Constants.OrderSides.BUY, FromAsset: symbol.QuoteAsset, ToAsset: symbol.BaseAsset})
+	}
+
+	routes := make([]*Spot_Route, 0)
+
+	// Breadth-first, so that shorter routes come first
+	paths := [][]*Spot_RouteLeg{nil}
+	for legCount := 1; legCount <= maxLegs; legCount++ {
+		nextPaths := make([][]*Spot_RouteLeg, 0)
+
+		for _, path := range paths {
+			currentAsset := fromAsset
+			if len(path) != 0 {
+				currentAsset = path[len(path)-1].ToAsset
+			}
+
+			for _, leg := range legsFrom[currentAsset] {
+				if leg.ToAsset == fromAsset || pathVisits(path, leg.ToAsset) {
+					continue
+				}
+
+				nextPath := append(append(make([]*Spot_RouteLeg, 0, len(path)+1), path...), leg)
+				if leg.ToAsset == toAsset {
+					routes = append(routes, &Spot_Route{Legs: nextPath})
+				} else {
+					nextPaths = append(nextPaths, nextPath)
+				}
+			}
+		}
+
+		paths = nextPaths
+	}
+
+	return routes
+}
+
+func pathVisits(path []*Spot_RouteLeg, asset string) bool {
+	for _, leg := range path {
+		if leg.ToAsset == asset {
+			return true
+		}
+	}
+	return false
+}
+
 //////// ExchangeInfo //
 /////////////////////////////////////////////////////////////////////////////////
 
@@ -646,20 +827,24 @@ func (spot *Spot) Candlesticks(symbol string, interval string, opt_params ...*Sp
 	// Convert the raw data to Spot_Candlestick slice
 	candlesticks := make([]*Spot_Candlestick, len(rawCandlesticks))
 	for i, raw := range rawCandlesticks {
+		var parser decimalParser
 		candlesticks[i] = &Spot_Candlestick{
 			OpenTime:                 int64(raw[0].(float64)),
-			Open:                     raw[1].(string),
-			High:                     raw[2].(string),
-			Low:                      raw[3].(string),
-			Close:                    raw[4].(string),
-			Volume:                   raw[5].(string),
+			Open:                     parser.kline(raw, 1),
+			High:                     parser.kline(raw, 2),
+			Low:                      parser.kline(raw, 3),
+			Close:                    parser.kline(raw, 4),
+			Volume:                   parser.kline(raw, 5),
 			CloseTime:                int64(raw[6].(float64)),
-			QuoteAssetVolume:         raw[7].(string),
+			QuoteAssetVolume:         parser.kline(raw, 7),
 			TradeCount:               int64(raw[8].(float64)),
-			TakerBuyBaseAssetVolume:  raw[9].(string),
-			TakerBuyQuoteAssetVolume: raw[10].(string),
+			TakerBuyBaseAssetVolume:  parser.kline(raw, 9),
+			TakerBuyQuoteAssetVolume: parser.kline(raw, 10),
 			Unused:                   raw[11].(string),
 		}
+		if parser.err != nil {
+			return nil, resp, LocalError(PARSING_ERR, parser.err.Error())
+		}
 	}
 
 	return candlesticks, resp, nil
@@ -708,20 +893,24 @@ func (spot *Spot) UIKlines(symbol string, interval string, opt_params ...*Spot_C
 	// Convert the raw data to Spot_Candlestick slice
 	candlesticks := make([]*Spot_Candlestick, len(rawCandlesticks))
 	for i, raw := range rawCandlesticks {
+		var parser decimalParser
 		candlesticks[i] = &Spot_Candlestick{
 			OpenTime:                 int64(raw[0].(float64)),
-			Open:                     raw[1].(string),
-			High:                     raw[2].(string),
-			Low:                      raw[3].(string),
-			Close:                    raw[4].(string),
-			Volume:                   raw[5].(string),
+			Open:                     parser.kline(raw, 1),
+			High:                     parser.kline(raw, 2),
+			Low:                      parser.kline(raw, 3),
+			Close:                    parser.kline(raw, 4),
+			Volume:                   parser.kline(raw, 5),
 			CloseTime:                int64(raw[6].(float64)),
-			QuoteAssetVolume:         raw[7].(string),
+			QuoteAssetVolume:         parser.kline(raw, 7),
 			TradeCount:               int64(raw[8].(float64)),
-			TakerBuyBaseAssetVolume:  raw[9].(string),
-			TakerBuyQuoteAssetVolume: raw[10].(string),
+			TakerBuyBaseAssetVolume:  parser.kline(raw, 9),
+			TakerBuyQuoteAssetVolume: parser.kline(raw, 10),
 			Unused:                   raw[11].(string),
 		}
+		if parser.err != nil {
+			return nil, resp, LocalError(PARSING_ERR, parser.err.Error())
+		}
 	}
 
 	return candlesticks, resp, nil
@@ -1,8 +1,6 @@
 package Binance
 
 import (
-	"fmt"
-	"math"
 	"strconv"
 	"sync"
 )
@@ -442,42 +440,33 @@ type Spot_Symbol struct {
 // "suggestion" must be ignored if it is returned as 0.
 // "suggestion" is always returned as "price" if it passes the filter.
 func (spotSymbol *Spot_Symbol) PRICE_FILTER(price float64) (isValid bool, reason string, suggestion float64, err *Error) {
-
-	if spotSymbol.Filters.PRICE_FILTER == nil {
-		return true, "", price, nil
-	}
-
-	minPrice, parseErr := strconv.ParseFloat(spotSymbol.Filters.PRICE_FILTER.MinPrice, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	maxPrice, parseErr := strconv.ParseFloat(spotSymbol.Filters.PRICE_FILTER.MaxPrice, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	tickSize, parseErr := strconv.ParseFloat(spotSymbol.Filters.PRICE_FILTER.TickSize, 64)
+	exactPrice, parseErr := NewDecimalFromFloat(price)
 	if parseErr != nil {
 		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
 	}
 
-	if minPrice != 0 && price < minPrice {
-		return false, "minPrice", minPrice, nil
+	isValid, reason, exactSuggestion, err := spotSymbol.PRICE_FILTER_Decimal(exactPrice)
+	if err != nil {
+		return false, "", 0, err
 	}
-
-	if maxPrice != 0 && price > maxPrice {
-		return false, "maxPrice", maxPrice, nil
+	if isValid {
+		return true, "", price, nil
 	}
 
-	if tickSize != 0 && math.Remainder(price, tickSize) != 0 {
-		suggestion, parseErr := strconv.ParseFloat(Format_TickSize_str(fmt.Sprint(price), spotSymbol.Filters.PRICE_FILTER.TickSize), 64)
-		if parseErr != nil {
-			return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-		}
+	return false, reason, exactSuggestion.Float64(), nil
+}
 
-		return false, "tickSize", suggestion, nil
+// # Checks if the price passes the "PRICE_FILTER", compared exactly
+//
+// Same as PRICE_FILTER(), without the float drift of "price" % tickSize
+func (spotSymbol *Spot_Symbol) PRICE_FILTER_Decimal(price Decimal) (isValid bool, reason string, suggestion Decimal, err *Error) {
+	filter := spotSymbol.Filters.PRICE_FILTER
+	if filter == nil {
+		return true, "", price, nil
 	}
 
-	return true, "", price, nil
+	isValid, reason, suggestion = checkFilterRange("PRICE_FILTER", "price", price, filter.MinPrice, filter.MaxPrice, filter.TickSize, "minPrice", "maxPrice", "tickSize")
+	return isValid, reason, suggestion, nil
 }
 
 // # Checks if the price passes the "PRICE_FILTER"
@@ -499,42 +488,33 @@ func (spotSymbol *Spot_Symbol) PRICE_FILTER_COMPACT(price float64) (isValid bool
 // "suggestion" must be ignored if it is returned as 0.
 // "suggestion" is always returned as "quantity" if it passes the filter.
 func (spotSymbol *Spot_Symbol) LOT_SIZE(quantity float64) (isValid bool, reason string, suggestion float64, err *Error) {
-
-	if spotSymbol.Filters.LOT_SIZE == nil {
-		return true, "", quantity, nil
-	}
-
-	minQty, parseErr := strconv.ParseFloat(spotSymbol.Filters.LOT_SIZE.MinQty, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	maxQty, parseErr := strconv.ParseFloat(spotSymbol.Filters.LOT_SIZE.MaxQty, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	stepSize, parseErr := strconv.ParseFloat(spotSymbol.Filters.LOT_SIZE.StepSize, 64)
+	exactQuantity, parseErr := NewDecimalFromFloat(quantity)
 	if parseErr != nil {
 		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
 	}
 
-	if minQty != 0 && quantity < minQty {
-		return false, "minQty", minQty, nil
+	isValid, reason, exactSuggestion, err := spotSymbol.LOT_SIZE_Decimal(exactQuantity)
+	if err != nil {
+		return false, "", 0, err
 	}
-
-	if maxQty != 0 && quantity > maxQty {
-		return false, "maxQty", maxQty, nil
+	if isValid {
+		return true, "", quantity, nil
 	}
 
-	if stepSize != 0 && math.Remainder(quantity, stepSize) != 0 {
-		suggestion, parseErr := strconv.ParseFloat(Format_TickSize_str(fmt.Sprint(quantity), spotSymbol.Filters.LOT_SIZE.StepSize), 64)
-		if parseErr != nil {
-			return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-		}
+	return false, reason, exactSuggestion.Float64(), nil
+}
 
-		return false, "stepSize", suggestion, nil
+// # Checks if the quantity passes the "LOT_SIZE", compared exactly
+//
+// Same as LOT_SIZE(), without the float drift of "quantity" % stepSize
+func (spotSymbol *Spot_Symbol) LOT_SIZE_Decimal(quantity Decimal) (isValid bool, reason string, suggestion Decimal, err *Error) {
+	filter := spotSymbol.Filters.LOT_SIZE
+	if filter == nil {
+		return true, "", quantity, nil
 	}
 
-	return true, "", quantity, nil
+	isValid, reason, suggestion = checkFilterRange("LOT_SIZE", "quantity", quantity, filter.MinQty, filter.MaxQty, filter.StepSize, "minQty", "maxQty", "stepSize")
+	return isValid, reason, suggestion, nil
 }
 
 // # Checks if the price passes the "LOT_SIZE"
@@ -556,47 +536,38 @@ func (spotSymbol *Spot_Symbol) LOT_SIZE_COMPACT(price float64) (isValid bool, er
 // "suggestion" must be ignored if it is returned as 0.
 // "suggestion" is always returned as "quantity" if it passes the filter.
 func (spotSymbol *Spot_Symbol) MARKET_LOT_SIZE(quantity float64) (isValid bool, reason string, suggestion float64, err *Error) {
-
-	if spotSymbol.Filters.LOT_SIZE == nil {
-		return true, "", quantity, nil
-	}
-
-	minQty, parseErr := strconv.ParseFloat(spotSymbol.Filters.MARKET_LOT_SIZE.MinQty, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	maxQty, parseErr := strconv.ParseFloat(spotSymbol.Filters.MARKET_LOT_SIZE.MaxQty, 64)
-	if parseErr != nil {
-		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-	}
-	stepSize, parseErr := strconv.ParseFloat(spotSymbol.Filters.MARKET_LOT_SIZE.StepSize, 64)
+	exactQuantity, parseErr := NewDecimalFromFloat(quantity)
 	if parseErr != nil {
 		return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
 	}
 
-	if minQty != 0 && quantity < minQty {
-		return false, "minQty", minQty, nil
+	isValid, reason, exactSuggestion, err := spotSymbol.MARKET_LOT_SIZE_Decimal(exactQuantity)
+	if err != nil {
+		return false, "", 0, err
 	}
-
-	if maxQty != 0 && quantity > maxQty {
-		return false, "maxQty", maxQty, nil
+	if isValid {
+		return true, "", quantity, nil
 	}
 
-	if stepSize != 0 && math.Remainder(quantity, stepSize) != 0 {
-		suggestion, parseErr := strconv.ParseFloat(Format_TickSize_str(fmt.Sprint(quantity), spotSymbol.Filters.MARKET_LOT_SIZE.StepSize), 64)
-		if parseErr != nil {
-			return false, "", 0, LocalError(PARSING_ERR, parseErr.Error())
-		}
+	return false, reason, exactSuggestion.Float64(), nil
+}
 
-		return false, "stepSize", suggestion, nil
+// # Checks if the quantity passes the "MARKET_LOT_SIZE", compared exactly
+//
+// Same as MARKET_LOT_SIZE(), without the float drift of "quantity" % stepSize
+func (spotSymbol *Spot_Symbol) MARKET_LOT_SIZE_Decimal(quantity Decimal) (isValid bool, reason string, suggestion Decimal, err *Error) {
+	filter := spotSymbol.Filters.MARKET_LOT_SIZE
+	if filter == nil {
+		return true, "", quantity, nil
 	}
 
-	return true, "", quantity, nil
+	isValid, reason, suggestion = checkFilterRange("MARKET_LOT_SIZE", "quantity", quantity, filter.MinQty, filter.MaxQty, filter.StepSize, "minQty", "maxQty", "stepSize")
+	return isValid, reason, suggestion, nil
 }
 
 // # Checks if the price passes the "MARKET_LOT_SIZE"
 func (spotSymbol *Spot_Symbol) MARKET_LOT_SIZE_COMPACT(price float64) (isValid bool, err *Error) {
-	isValid, _, _, err = spotSymbol.LOT_SIZE(price)
+	isValid, _, _, err = spotSymbol.MARKET_LOT_SIZE(price)
 	return isValid, err
 }
 
@@ -607,17 +578,17 @@ func (spotSymbol *Spot_Symbol) MARKET_LOT_SIZE_COMPACT(price float64) (isValid b
 // i.e: BTCUSDT has a precision of 5, meaning if you want to buy "0.12345678" BTC,
 // it would be truncated down to "0.12345" BTC
 func (spotSymbol *Spot_Symbol) TruncQuantity_float64(quantity float64, IsForMarketOrder bool) string {
-	return spotSymbol.TruncQuantity(fmt.Sprint(quantity), IsForMarketOrder)
+	return spotSymbol.TruncQuantity(strconv.FormatFloat(quantity, 'f', -1, 64), IsForMarketOrder)
 }
 
 func (spotSymbol *Spot_Symbol) TruncQuantity(quantity string, IsForMarketOrder bool) string {
 	truncQuantity := quantity
-	if spotSymbol.Filters.LOT_SIZE != nil && spotSymbol.Filters.LOT_SIZE.StepSize != "" {
-		truncQuantity = Format_TickSize_str(truncQuantity, spotSymbol.Filters.LOT_SIZE.StepSize)
+	if spotSymbol.Filters.LOT_SIZE != nil && !spotSymbol.Filters.LOT_SIZE.StepSize.IsZero() {
+		truncQuantity = Format_TickSize_str(truncQuantity, spotSymbol.Filters.LOT_SIZE.StepSize.String())
 	}
 
-	if IsForMarketOrder && spotSymbol.Filters.MARKET_LOT_SIZE != nil && spotSymbol.Filters.MARKET_LOT_SIZE.StepSize != "" {
-		truncQuantity = Format_TickSize_str(truncQuantity, spotSymbol.Filters.MARKET_LOT_SIZE.StepSize)
+	if IsForMarketOrder && spotSymbol.Filters.MARKET_LOT_SIZE != nil && !spotSymbol.Filters.MARKET_LOT_SIZE.StepSize.IsZero() {
+		truncQuantity = Format_TickSize_str(truncQuantity, spotSymbol.Filters.MARKET_LOT_SIZE.StepSize.String())
 	}
 
 	return truncQuantity
@@ -629,7 +600,7 @@ func (spotSymbol *Spot_Symbol) TruncQuantity(quantity string, IsForMarketOrder b
 // i.e: BTCUSDT has a precision of 2, meaning if you want to buy BTCUSDT at "123_456.7891",
 // it would be truncated down to "123_456.78"
 func (spotSymbol *Spot_Symbol) TruncPrice_float64(price float64) string {
-	return spotSymbol.TruncPrice(fmt.Sprint(price))
+	return spotSymbol.TruncPrice(strconv.FormatFloat(price, 'f', -1, 64))
 }
 
 // # Truncates a price string to the last significant digit
@@ -638,11 +609,11 @@ func (spotSymbol *Spot_Symbol) TruncPrice_float64(price float64) string {
 // i.e: BTCUSDT has a precision of 2, meaning if you want to buy BTCUSDT at "123_456.7891",
 // it would be truncated down to "123_456.78"
 func (spotSymbol *Spot_Symbol) TruncPrice(priceStr string) string {
-	if spotSymbol.Filters.PRICE_FILTER == nil || spotSymbol.Filters.PRICE_FILTER.TickSize == "" {
+	if spotSymbol.Filters.PRICE_FILTER == nil || spotSymbol.Filters.PRICE_FILTER.TickSize.IsZero() {
 		return priceStr
 	}
 
-	return Format_TickSize_str(priceStr, spotSymbol.Filters.PRICE_FILTER.TickSize)
+	return Format_TickSize_str(priceStr, spotSymbol.Filters.PRICE_FILTER.TickSize.String())
 }
 
 type Spot_SymbolFilters struct {
@@ -667,10 +638,10 @@ type Spot_ExchangeInfo_SORS struct {
 }
 
 type Spot_SymbolFilter_PRICE_FILTER struct {
-	FilterType string `json:"filterType"`
-	MinPrice   string `json:"minPrice"`
-	MaxPrice   string `json:"maxPrice"`
-	TickSize   string `json:"tickSize"`
+	FilterType string  `json:"filterType"`
+	MinPrice   Decimal `json:"minPrice"`
+	MaxPrice   Decimal `json:"maxPrice"`
+	TickSize   Decimal `json:"tickSize"`
 }
 
 type Spot_SymbolFilter_PERCENT_PRICE struct {
@@ -690,10 +661,10 @@ type Spot_SymbolFilter_PERCENT_PRICE_BY_SIDE struct {
 }
 
 type Spot_SymbolFilter_LOT_SIZE struct {
-	FilterType string `json:"filterType"`
-	MinQty     string `json:"minQty"`
-	MaxQty     string `json:"maxQty"`
-	StepSize   string `json:"stepSize"`
+	FilterType string  `json:"filterType"`
+	MinQty     Decimal `json:"minQty"`
+	MaxQty     Decimal `json:"maxQty"`
+	StepSize   Decimal `json:"stepSize"`
 }
 
 type Spot_SymbolFilter_MIN_NOTIONAL struct {
@@ -718,10 +689,10 @@ type Spot_SymbolFilter_ICEBERG_PARTS struct {
 }
 
 type Spot_SymbolFilter_MARKET_LOT_SIZE struct {
-	FilterType string `json:"filterType"`
-	MinQty     string `json:"minQty"`
-	MaxQty     string `json:"maxQty"`
-	StepSize   string `json:"stepSize"`
+	FilterType string  `json:"filterType"`
+	MinQty     Decimal `json:"minQty"`
+	MaxQty     Decimal `json:"maxQty"`
+	StepSize   Decimal `json:"stepSize"`
 }
 
 type Spot_SymbolFilter_MAX_NUM_ORDERS struct {
@@ -782,33 +753,33 @@ type Spot_OrderBook struct {
 	//      "431.00000000"    // QTY
 	//    ]
 	//  ]
-	Bids [][2]string `json:"bids"`
+	Bids [][2]Decimal `json:"bids"`
 	// 	"asks": [
 	//     [
 	//       "4.00000200",
 	//       "12.00000000"
 	//     ]
 	//   ]
-	Asks [][2]string `json:"asks"`
+	Asks [][2]Decimal `json:"asks"`
 }
 
 type Spot_Trade struct {
-	Id           int64  `json:"id"`
-	Price        string `json:"price"`
-	Qty          string `json:"qty"`
-	QuoteQty     string `json:"quoteQty"`
-	Time         int64  `json:"time"`
-	IsBuyerMaker bool   `json:"isBuyerMaker"`
-	IsBestMatch  bool   `json:"isBestMatch"`
+	Id           int64   `json:"id"`
+	Price        Decimal `json:"price"`
+	Qty          Decimal `json:"qty"`
+	QuoteQty     Decimal `json:"quoteQty"`
+	Time         int64   `json:"time"`
+	IsBuyerMaker bool    `json:"isBuyerMaker"`
+	IsBestMatch  bool    `json:"isBestMatch"`
 }
 
 type Spot_AggTrade struct {
 	// Aggregate tradeId
 	AggTradeId int64 `json:"a"`
 	// Price
-	Price string `json:"p"`
+	Price Decimal `json:"p"`
 	// Quantity
-	Quantity string `json:"q"`
+	Quantity Decimal `json:"q"`
 	// First tradeId
 	FirstTradeId int64 `json:"f"`
 	// Last tradeId
@@ -825,25 +796,25 @@ type Spot_Candlestick struct {
 	// Kline open time
 	OpenTime int64
 	// Open price
-	Open string
+	Open Decimal
 	// High price
-	High string
+	High Decimal
 	// Low price
-	Low string
+	Low Decimal
 	// Close price
-	Close string
+	Close Decimal
 	// Volume
-	Volume string
+	Volume Decimal
 	// Kline Close time
 	CloseTime int64
 	// Quote asset volume
-	QuoteAssetVolume string
+	QuoteAssetVolume Decimal
 	// Number of trades
 	TradeCount int64
 	// Taker buy base asset volume
-	TakerBuyBaseAssetVolume string
+	TakerBuyBaseAssetVolume Decimal
 	// Taker buy quote asset volume
-	TakerBuyQuoteAssetVolume string
+	TakerBuyQuoteAssetVolume Decimal
 	// Unused field, ignore.
 	Unused string
 }
@@ -852,7 +823,7 @@ type Spot_AveragePrice struct {
 	// Average price interval (in minutes)
 	Mins int64 `json:"mins"`
 	// Average price
-	Price string `json:"price"`
+	Price Decimal `json:"price"`
 	// Last trade time
 	CloseTime int64 `json:"closeTime"`
 }
@@ -860,35 +831,35 @@ type Spot_AveragePrice struct {
 type Spot_Ticker_RollingWindow24h struct {
 	Symbol string `json:"symbol"`
 
-	PriceChange string `json:"priceChange"`
+	PriceChange Decimal `json:"priceChange"`
 
-	PriceChangePercent string `json:"priceChangePercent"`
+	PriceChangePercent Decimal `json:"priceChangePercent"`
 
-	WeightedAvgPrice string `json:"weightedAvgPrice"`
+	WeightedAvgPrice Decimal `json:"weightedAvgPrice"`
 
-	PrevClosePrice string `json:"prevClosePrice"`
+	PrevClosePrice Decimal `json:"prevClosePrice"`
 
-	LastPrice string `json:"lastPrice"`
+	LastPrice Decimal `json:"lastPrice"`
 
-	LastQty string `json:"lastQty"`
+	LastQty Decimal `json:"lastQty"`
 
-	BidPrice string `json:"bidPrice"`
+	BidPrice Decimal `json:"bidPrice"`
 
-	BidQty string `json:"bidQty"`
+	BidQty Decimal `json:"bidQty"`
 
-	AskPrice string `json:"askPrice"`
+	AskPrice Decimal `json:"askPrice"`
 
-	AskQty string `json:"askQty"`
+	AskQty Decimal `json:"askQty"`
 
-	OpenPrice string `json:"openPrice"`
+	OpenPrice Decimal `json:"openPrice"`
 
-	HighPrice string `json:"highPrice"`
+	HighPrice Decimal `json:"highPrice"`
 
-	LowPrice string `json:"lowPrice"`
+	LowPrice Decimal `json:"lowPrice"`
 
-	Volume string `json:"volume"`
+	Volume Decimal `json:"volume"`
 
-	QuoteVolume string `json:"quoteVolume"`
+	QuoteVolume Decimal `json:"quoteVolume"`
 
 	OpenTime int64 `json:"openTime"`
 
@@ -908,26 +879,26 @@ type Spot_Ticker_RollingWindow struct {
 	Symbol string `json:"symbol"`
 
 	// Absolute price change
-	PriceChange string `json:"priceChange"`
+	PriceChange Decimal `json:"priceChange"`
 
 	// Relative price change in percent
-	PriceChangePercent string `json:"priceChangePercent"`
+	PriceChangePercent Decimal `json:"priceChangePercent"`
 
 	// QuoteVolume / Volume
-	WeightedAvgPrice string `json:"weightedAvgPrice"`
+	WeightedAvgPrice Decimal `json:"weightedAvgPrice"`
 
-	OpenPrice string `json:"openPrice"`
+	OpenPrice Decimal `json:"openPrice"`
 
-	HighPrice string `json:"highPrice"`
+	HighPrice Decimal `json:"highPrice"`
 
-	LowPrice string `json:"lowPrice"`
+	LowPrice Decimal `json:"lowPrice"`
 
-	LastPrice string `json:"lastPrice"`
+	LastPrice Decimal `json:"lastPrice"`
 
-	Volume string `json:"volume"`
+	Volume Decimal `json:"volume"`
 
 	// Sum of (price * volume) for all trades
-	QuoteVolume string `json:"quoteVolume"`
+	QuoteVolume Decimal `json:"quoteVolume"`
 
 	// Open time for ticker window
 	OpenTime int64 `json:"openTime"`
@@ -950,22 +921,22 @@ type Spot_MiniTicker_RollingWindow24h struct {
 	Symbol string `json:"symbol"`
 
 	// Opening price of the Interval
-	OpenPrice string `json:"openPrice"`
+	OpenPrice Decimal `json:"openPrice"`
 
 	// Highest price in the interval
-	HighPrice string `json:"highPrice"`
+	HighPrice Decimal `json:"highPrice"`
 
 	// Lowest  price in the interval
-	LowPrice string `json:"lowPrice"`
+	LowPrice Decimal `json:"lowPrice"`
 
 	// Closing price of the interval
-	LastPrice string `json:"lastPrice"`
+	LastPrice Decimal `json:"lastPrice"`
 
 	// Total trade volume (in base asset)
-	Volume string `json:"volume"`
+	Volume Decimal `json:"volume"`
 
 	// Total trade volume (in quote asset)
-	QuoteVolume string `json:"quoteVolume"`
+	QuoteVolume Decimal `json:"quoteVolume"`
 
 	// Start of the ticker interval
 	OpenTime int64 `json:"openTime"`
@@ -986,18 +957,18 @@ type Spot_MiniTicker_RollingWindow24h struct {
 type Spot_MiniTicker_RollingWindow struct {
 	Symbol string `json:"symbol"`
 
-	OpenPrice string `json:"openPrice"`
+	OpenPrice Decimal `json:"openPrice"`
 
-	HighPrice string `json:"highPrice"`
+	HighPrice Decimal `json:"highPrice"`
 
-	LowPrice string `json:"lowPrice"`
+	LowPrice Decimal `json:"lowPrice"`
 
-	LastPrice string `json:"lastPrice"`
+	LastPrice Decimal `json:"lastPrice"`
 
-	Volume string `json:"volume"`
+	Volume Decimal `json:"volume"`
 
 	// Sum of (price * volume) for all trades
-	QuoteVolume string `json:"quoteVolume"`
+	QuoteVolume Decimal `json:"quoteVolume"`
 
 	// Open time for ticker window
 	OpenTime int64 `json:"openTime"`
@@ -1018,27 +989,27 @@ type Spot_Ticker struct {
 	Symbol string `json:"symbol"`
 
 	// Absolute price change
-	PriceChange string `json:"priceChange"`
+	PriceChange Decimal `json:"priceChange"`
 
 	// Relative price change in percent
-	PriceChangePercent string `json:"priceChangePercent"`
+	PriceChangePercent Decimal `json:"priceChangePercent"`
 
 	// quoteVolume / volume
-	WeightedAvgPrice string `json:"weightedAvgPrice"`
+	WeightedAvgPrice Decimal `json:"weightedAvgPrice"`
 
-	OpenPrice string `json:"openPrice"`
+	OpenPrice Decimal `json:"openPrice"`
 
-	HighPrice string `json:"highPrice"`
+	HighPrice Decimal `json:"highPrice"`
 
-	LowPrice string `json:"lowPrice"`
+	LowPrice Decimal `json:"lowPrice"`
 
-	LastPrice string `json:"lastPrice"`
+	LastPrice Decimal `json:"lastPrice"`
 
 	// Volume in base asset
-	Volume string `json:"volume"`
+	Volume Decimal `json:"volume"`
 
 	// Volume in quote asset
-	QuoteVolume string `json:"quoteVolume"`
+	QuoteVolume Decimal `json:"quoteVolume"`
 
 	OpenTime int64 `json:"openTime"`
 
@@ -1057,19 +1028,19 @@ type Spot_Ticker struct {
 type Spot_MiniTicker struct {
 	Symbol string `json:"symbol"`
 
-	OpenPrice string `json:"openPrice"`
+	OpenPrice Decimal `json:"openPrice"`
 
-	HighPrice string `json:"highPrice"`
+	HighPrice Decimal `json:"highPrice"`
 
-	LowPrice string `json:"lowPrice"`
+	LowPrice Decimal `json:"lowPrice"`
 
-	LastPrice string `json:"lastPrice"`
+	LastPrice Decimal `json:"lastPrice"`
 
 	// Volume in base asset
-	Volume string `json:"volume"`
+	Volume Decimal `json:"volume"`
 
 	// Volume in quote asset
-	QuoteVolume string `json:"quoteVolume"`
+	QuoteVolume Decimal `json:"quoteVolume"`
 
 	OpenTime int64 `json:"openTime"`
 
@@ -1086,20 +1057,20 @@ type Spot_MiniTicker struct {
 }
 
 type Spot_PriceTicker struct {
-	Symbol string `json:"symbol"`
-	Price  string `json:"price"`
+	Symbol string  `json:"symbol"`
+	Price  Decimal `json:"price"`
 }
 
 type Spot_BookTicker struct {
 	Symbol string `json:"symbol"`
 
-	BidPrice string `json:"bidPrice"`
+	BidPrice Decimal `json:"bidPrice"`
 
-	BidQty string `json:"bidQty"`
+	BidQty Decimal `json:"bidQty"`
 
-	AskPrice string `json:"askPrice"`
+	AskPrice Decimal `json:"askPrice"`
 
-	AskQty string `json:"askQty"`
+	AskQty Decimal `json:"askQty"`
 }
 
 /////////////////////////////////////////////////////////////////////////////////
@@ -1112,11 +1083,11 @@ type Spot_Order struct {
 	OrderListId             int64               `json:"orderListId"`
 	ClientOrderId           string              `json:"clientOrderId"`
 	TransactTime            int64               `json:"transactTime"`
-	Price                   string              `json:"price"`
-	OrigQty                 string              `json:"origQty"`
-	ExecutedQty             string              `json:"executedQty"`
-	OrigQuoteOrderQty       string              `json:"origQuoteOrderQty"`
-	CummulativeQuoteQty     string              `json:"cummulativeQuoteQty"`
+	Price                   Decimal             `json:"price"`
+	OrigQty                 Decimal             `json:"origQty"`
+	ExecutedQty             Decimal             `json:"executedQty"`
+	OrigQuoteOrderQty       Decimal             `json:"origQuoteOrderQty"`
+	CummulativeQuoteQty     Decimal             `json:"cummulativeQuoteQty"`
 	Status                  string              `json:"status"`
 	TimeInForce             string              `json:"timeInForce"`
 	Type                    string              `json:"type"`
@@ -1127,11 +1098,11 @@ type Spot_Order struct {
 }
 
 type Spot_Order_Fills struct {
-	Price           string `json:"price"`
-	Qty             string `json:"qty"`
-	Commission      string `json:"commission"`
-	CommissionAsset string `json:"commissionAsset"`
-	TradeId         int64  `json:"tradeId"`
+	Price           Decimal `json:"price"`
+	Qty             Decimal `json:"qty"`
+	Commission      string  `json:"commission"`
+	CommissionAsset string  `json:"commissionAsset"`
+	TradeId         int64   `json:"tradeId"`
 }
 
 /////////////////////////////////////////////////////////////////////////////////
@@ -3,9 +3,7 @@ package Binance
 import (
 	"strconv"
 	"strings"
-
-	"github.com/google/uuid"
-	ws "github.com/gorilla/websocket"
+	"time"
 )
 
 type Spot_Websockets struct {
@@ -14,11 +12,8 @@ type Spot_Websockets struct {
 
 type Spot_Websocket struct {
 	Websocket *Websocket
-	Conn      *ws.Conn
 	// Host server's URL
 	BaseURL string
-
-	pendingRequests map[string]chan []byte
 }
 
 func (spot_ws *Spot_Websocket) Close() error {
@@ -26,46 +21,69 @@ func (spot_ws *Spot_Websocket) Close() error {
 }
 
 // Forcefully reconnects the socket
-// Also makes it a reconnecting socket if it weren't before
-// Useless, but there nonetheless...
+// Does nothing if the socket is closed or already reconnecting
 func (spot_ws *Spot_Websocket) Reconnect() {
 	spot_ws.Websocket.Reconnect()
 }
 
 func (spot_ws *Spot_Websocket) SetMessageListener(f func(messageType int, msg []byte)) {
-	spot_ws.Websocket.OnMessage = f
+	spot_ws.Websocket.SetMessageListener(f)
+}
+
+// Receives every stream message with its stream's name, see Websocket.SetStreamMessageListener()
+func (spot_ws *Spot_Websocket) SetStreamMessageListener(f func(stream string, msg []byte)) {
+	spot_ws.Websocket.SetStreamMessageListener(f)
+}
+
+// Receives the messages that could not be decoded, they are logged otherwise
+func (spot_ws *Spot_Websocket) SetErrorListener(f func(err *WebsocketDecodeError)) {
+	spot_ws.Websocket.SetErrorListener(f)
+}
+
+// Called when a reconciliation finds the subscriptions drifted, see Websocket.Reconcile()
+func (spot_ws *Spot_Websocket) SetDriftListener(f func(drift *SubscriptionDrift)) {
+	spot_ws.Websocket.SetDriftListener(f)
+}
+
+// Reconciles the socket's subscriptions with Binance's every "interval_sec", see Websocket.StartReconciliation()
+func (spot_ws *Spot_Websocket) StartReconciliation(interval_sec ...int64) {
+	spot_ws.Websocket.StartReconciliation(interval_sec...)
+}
+
+func (spot_ws *Spot_Websocket) StopReconciliation() {
+	spot_ws.Websocket.StopReconciliation()
 }
 
 func (spot_ws *Spot_Websocket) SetPingListener(f func(appData string)) {
-	spot_ws.Websocket.OnPing = f
+	spot_ws.Websocket.SetPingListener(f)
 }
 
 func (spot_ws *Spot_Websocket) SetPongListener(f func(appData string)) {
-	spot_ws.Websocket.OnPong = f
+	spot_ws.Websocket.SetPongListener(f)
 }
 
 // This is called when socket has been disconnected
 // Called when the detected a disconnection and wants to reconnect afterwards
 // Usually called right before the 'ReconnectingListener'
 func (spot_ws *Spot_Websocket) SetDisconnectListener(f func(code int, text string)) {
-	spot_ws.Websocket.OnDisconnect = f
+	spot_ws.Websocket.SetDisconnectListener(f)
 }
 
 // This is called when socket began reconnecting
 func (spot_ws *Spot_Websocket) SetReconnectingListener(f func()) {
-	spot_ws.Websocket.OnReconnecting = f
+	spot_ws.Websocket.SetReconnectingListener(f)
 }
 
 // This is called when the socket has successfully reconnected after a disconnection
 func (spot_ws *Spot_Websocket) SetReconnectListener(f func()) {
-	spot_ws.Websocket.OnReconnect = f
+	spot_ws.Websocket.SetReconnectListener(f)
 }
 
 // This is called when the websocket closes indefinitely
 // Meaning when you invoke the 'Close()' method
 // Or any other way a websocket is set to never reconnect on a disconnection
 func (spot_ws *Spot_Websocket) SetCloseListener(f func(code int, text string)) {
-	spot_ws.Websocket.OnClose = f
+	spot_ws.Websocket.SetCloseListener(f)
 }
 
 type SpotWS_PrivateMessage struct {
@@ -94,10 +112,22 @@ type SpotWS_AggTrade struct {
 	IsMaker bool `json:"m"`
 	// Ignore
 	Ignore bool `json:"M"`
+
+	// Fetched through the REST API after a reconnection, see SetBackfill()
+	IsBackfilled bool `json:"-"`
 }
 
 type SpotWS_AggTrade_Socket struct {
 	Handler *Spot_Websocket
+
+	backfill *streamBackfill[*SpotWS_AggTrade]
+}
+
+// # Backfills the aggregate trades missed while reconnecting, disabled by default
+//
+// They are fetched from the last AggTradeId received and delivered to the same listener, in order and before the live ones, with IsBackfilled set.
+func (socket *SpotWS_AggTrade_Socket) SetBackfill(enabled bool) {
+	socket.backfill.setEnabled(enabled)
 }
 
 func (*SpotWS_AggTrade_Socket) CreateStreamName(symbol string) string {
@@ -128,20 +158,63 @@ func (spot_ws *Spot_Websockets) AggTrade(publicOnMessage func(aggTrade *SpotWS_A
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	newSocket.backfill = &streamBackfill[*SpotWS_AggTrade]{
+		deliver:  publicOnMessage,
+		key:      func(aggTrade *SpotWS_AggTrade) string { return aggTrade.Symbol },
+		sequence: func(aggTrade *SpotWS_AggTrade) int64 { return aggTrade.AggTradeId },
+		fetch: func(last *SpotWS_AggTrade) ([]*SpotWS_AggTrade, *Error) {
+			return spot_ws.backfillAggTrades(last)
+		},
+	}
+	newSocket.backfill.attach(socket.Websocket)
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var aggTrade SpotWS_AggTrade
 		err := json.Unmarshal(msg, &aggTrade)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
-		publicOnMessage(&aggTrade)
-	}
+		newSocket.backfill.onMessage(&aggTrade)
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
 }
 
+func (spot_ws *Spot_Websockets) backfillAggTrades(last *SpotWS_AggTrade) ([]*SpotWS_AggTrade, *Error) {
+	const limit = 1000
+
+	aggTrades, err := fetchPages(limit,
+		func(fromId int64) ([]*Spot_AggTrade, *Error) {
+			aggTrades, _, err := spot_ws.binance.Spot.AggTrades(last.Symbol, &Spot_AggTrades_Params{FromId: fromId, Limit: limit})
+			return aggTrades, err
+		},
+		last.AggTradeId+1,
+		func(aggTrade *Spot_AggTrade) int64 { return aggTrade.AggTradeId + 1 },
+	)
+
+	backfilled := make([]*SpotWS_AggTrade, len(aggTrades))
+	for i, aggTrade := range aggTrades {
+		backfilled[i] = &SpotWS_AggTrade{
+			Event:        last.Event,
+			EventTime:    aggTrade.Timestamp,
+			Symbol:       last.Symbol,
+			AggTradeId:   aggTrade.AggTradeId,
+			Price:        aggTrade.Price.String(),
+			Quantity:     aggTrade.Quantity.String(),
+			FirstTradeId: aggTrade.FirstTradeId,
+			LastTradeId:  aggTrade.LastTradeId,
+			Timestamp:    aggTrade.Timestamp,
+			IsMaker:      aggTrade.IsMaker,
+			Ignore:       aggTrade.IsBestMatch,
+			IsBackfilled: true,
+		}
+	}
+
+	return backfilled, err
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type SpotWS_Trade struct {
@@ -158,10 +231,22 @@ type SpotWS_Trade struct {
 	IsMaker bool `json:"m"`
 	// Ignore
 	Ignore bool `json:"M"`
+
+	// Fetched through the REST API after a reconnection, see SetBackfill()
+	IsBackfilled bool `json:"-"`
 }
 
 type SpotWS_Trade_Socket struct {
 	Handler *Spot_Websocket
+
+	backfill *streamBackfill[*SpotWS_Trade]
+}
+
+// # Backfills the trades missed while reconnecting, disabled by default
+//
+// They are fetched from the last TradeID received and delivered to the same listener, in order and before the live ones, with IsBackfilled set.
+func (socket *SpotWS_Trade_Socket) SetBackfill(enabled bool) {
+	socket.backfill.setEnabled(enabled)
 }
 
 func (*SpotWS_Trade_Socket) CreateStreamName(symbol string) string {
@@ -192,20 +277,61 @@ func (spot_ws *Spot_Websockets) Trade(publicOnMessage func(trade *SpotWS_Trade),
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	newSocket.backfill = &streamBackfill[*SpotWS_Trade]{
+		deliver:  publicOnMessage,
+		key:      func(trade *SpotWS_Trade) string { return trade.Symbol },
+		sequence: func(trade *SpotWS_Trade) int64 { return trade.TradeID },
+		fetch: func(last *SpotWS_Trade) ([]*SpotWS_Trade, *Error) {
+			return spot_ws.backfillTrades(last)
+		},
+	}
+	newSocket.backfill.attach(socket.Websocket)
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var trade SpotWS_Trade
 		err := json.Unmarshal(msg, &trade)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
-		publicOnMessage(&trade)
-	}
+		newSocket.backfill.onMessage(&trade)
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
 }
 
+func (spot_ws *Spot_Websockets) backfillTrades(last *SpotWS_Trade) ([]*SpotWS_Trade, *Error) {
+	const limit = 1000
+
+	trades, err := fetchPages(limit,
+		func(fromId int64) ([]*Spot_Trade, *Error) {
+			trades, _, err := spot_ws.binance.Spot.OldTrades(last.Symbol, &Spot_OldTrades_Params{FromId: fromId, Limit: limit})
+			return trades, err
+		},
+		last.TradeID+1,
+		func(trade *Spot_Trade) int64 { return trade.Id + 1 },
+	)
+
+	backfilled := make([]*SpotWS_Trade, len(trades))
+	for i, trade := range trades {
+		backfilled[i] = &SpotWS_Trade{
+			Event:        last.Event,
+			EventTime:    trade.Time,
+			Symbol:       last.Symbol,
+			TradeID:      trade.Id,
+			Price:        trade.Price.String(),
+			Quantity:     trade.Qty.String(),
+			Timestamp:    trade.Time,
+			IsMaker:      trade.IsBuyerMaker,
+			Ignore:       trade.IsBestMatch,
+			IsBackfilled: true,
+		}
+	}
+
+	return backfilled, err
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type SpotWS_Candlestick_MSG struct {
@@ -220,6 +346,11 @@ type SpotWS_Candlestick_MSG struct {
 	Symbol string `json:"s"`
 
 	Candle *SpotWS_Candlestick `json:"k"`
+
+	// Fetched through the REST API after a reconnection, see SetBackfill()
+	//
+	// The REST API doesn't return the candle's FirstTradeId and LastTradeId, they are 0
+	IsBackfilled bool `json:"-"`
 }
 type SpotWS_Candlestick struct {
 
@@ -282,6 +413,16 @@ type SpotWS_Candlestick_StreamIdentifier struct {
 
 type SpotWS_Candlestick_Socket struct {
 	Handler *Spot_Websocket
+
+	backfill *streamBackfill[*SpotWS_Candlestick_MSG]
+}
+
+// # Backfills the candles missed while reconnecting, disabled by default
+//
+// They are fetched from the last candle received (included, as it may have closed meanwhile)
+// and delivered to the same listener, in order and before the live ones, with IsBackfilled set.
+func (socket *SpotWS_Candlestick_Socket) SetBackfill(enabled bool) {
+	socket.backfill.setEnabled(enabled)
 }
 
 func (*SpotWS_Candlestick_Socket) CreateStreamName(symbol string, interval string) string {
@@ -318,20 +459,81 @@ func (spot_ws *Spot_Websockets) Candlesticks(publicOnMessage func(candlestick_ms
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	newSocket.backfill = &streamBackfill[*SpotWS_Candlestick_MSG]{
+		deliver: publicOnMessage,
+		key: func(candlestick_msg *SpotWS_Candlestick_MSG) string {
+			return candlestick_msg.Symbol + "@" + candlestick_msg.Candle.Interval
+		},
+		sequence:    func(candlestick_msg *SpotWS_Candlestick_MSG) int64 { return candlestick_msg.Candle.OpenTime },
+		allowRepeat: true,
+		fetch: func(last *SpotWS_Candlestick_MSG) ([]*SpotWS_Candlestick_MSG, *Error) {
+			return spot_ws.backfillCandlesticks(last)
+		},
+	}
+	newSocket.backfill.attach(socket.Websocket)
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var candlestick_msg SpotWS_Candlestick_MSG
 		err := json.Unmarshal(msg, &candlestick_msg)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
-		publicOnMessage(&candlestick_msg)
-	}
+		if candlestick_msg.Candle == nil {
+			publicOnMessage(&candlestick_msg)
+			return
+		}
+		newSocket.backfill.onMessage(&candlestick_msg)
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
 }
 
+func (spot_ws *Spot_Websockets) backfillCandlesticks(last *SpotWS_Candlestick_MSG) ([]*SpotWS_Candlestick_MSG, *Error) {
+	const limit = 1000
+
+	candlesticks, err := fetchPages(limit,
+		func(startTime int64) ([]*Spot_Candlestick, *Error) {
+			candlesticks, _, err := spot_ws.binance.Spot.Candlesticks(last.Symbol, last.Candle.Interval, &Spot_Candlesticks_Params{StartTime: startTime, Limit: limit})
+			return candlesticks, err
+		},
+		last.Candle.OpenTime,
+		func(candlestick *Spot_Candlestick) int64 { return candlestick.OpenTime + 1 },
+	)
+
+	now := time.Now().UnixMilli()
+
+	backfilled := make([]*SpotWS_Candlestick_MSG, len(candlesticks))
+	for i, candlestick := range candlesticks {
+		backfilled[i] = &SpotWS_Candlestick_MSG{
+			Event:     last.Event,
+			EventTime: now,
+			Symbol:    last.Symbol,
+			Candle: &SpotWS_Candlestick{
+				OpenTime:                 candlestick.OpenTime,
+				CloseTime:                candlestick.CloseTime,
+				Symbol:                   last.Symbol,
+				Interval:                 last.Candle.Interval,
+				Open:                     candlestick.Open.String(),
+				Close:                    candlestick.Close.String(),
+				High:                     candlestick.High.String(),
+				Low:                      candlestick.Low.String(),
+				BaseAssetVolume:          candlestick.Volume.String(),
+				TradeCount:               candlestick.TradeCount,
+				IsClosed:                 candlestick.CloseTime < now,
+				QuoteAssetVolume:         candlestick.QuoteAssetVolume.String(),
+				TakerBuyBaseAssetVolume:  candlestick.TakerBuyBaseAssetVolume.String(),
+				TakerBuyQuoteAssetVolume: candlestick.TakerBuyQuoteAssetVolume.String(),
+				Ignore:                   candlestick.Unused,
+			},
+			IsBackfilled: true,
+		}
+	}
+
+	return backfilled, err
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type SpotWS_Candlestick_TimezoneOffset_Socket struct {
@@ -371,15 +573,15 @@ func (spot_ws *Spot_Websockets) Candlestick_WithOffset(publicOnMessage func(cand
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var candlestick_msg SpotWS_Candlestick_MSG
 		err := json.Unmarshal(msg, &candlestick_msg)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(&candlestick_msg)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -450,15 +652,15 @@ func (spot_ws *Spot_Websockets) MiniTicker(publicOnMessage func(miniTicker *Spot
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var miniTicker SpotWS_MiniTicker
 		err := json.Unmarshal(msg, &miniTicker)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(&miniTicker)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -488,15 +690,15 @@ func (spot_ws *Spot_Websockets) AllMiniTickers(publicOnMessage func(miniTickers
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var miniTickers []*SpotWS_MiniTicker
 		err := json.Unmarshal(msg, &miniTickers)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(miniTickers)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -609,15 +811,15 @@ func (spot_ws *Spot_Websockets) Ticker(publicOnMessage func(ticker *SpotWS_Ticke
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var ticker SpotWS_Ticker
 		err := json.Unmarshal(msg, &ticker)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(&ticker)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -647,15 +849,15 @@ func (spot_ws *Spot_Websockets) AllTickers(publicOnMessage func(tickers []*SpotW
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var tickers []*SpotWS_Ticker
 		err := json.Unmarshal(msg, &tickers)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(tickers)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -760,15 +962,15 @@ func (spot_ws *Spot_Websockets) RollingWindowStatistics(publicOnMessage func(rwS
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var rwStat SpotWS_RollingWindowStatistic
 		err := json.Unmarshal(msg, &rwStat)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(&rwStat)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -811,15 +1013,15 @@ func (spot_ws *Spot_Websockets) AllRollingWindowStatistics(publicOnMessage func(
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var rwStats []*SpotWS_RollingWindowStatistic
 		err := json.Unmarshal(msg, &rwStats)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(rwStats)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -883,15 +1085,15 @@ func (spot_ws *Spot_Websockets) BookTicker(publicOnMessage func(bookTicker *Spot
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var bookTicker *SpotWS_BookTicker
 		err := json.Unmarshal(msg, &bookTicker)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(bookTicker)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -955,15 +1157,15 @@ func (spot_ws *Spot_Websockets) AveragePrice(publicOnMessage func(averagePrice *
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var averagePrice *SpotWS_AveragePrice
 		err := json.Unmarshal(msg, &averagePrice)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(averagePrice)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1068,15 +1270,15 @@ func (spot_ws *Spot_Websockets) PartialBookDepth(publicOnMessage func(partialBoo
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var partialBookDepth *SpotWS_PartialBookDepth
 		err := json.Unmarshal(msg, &partialBookDepth)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(partialBookDepth)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1180,15 +1382,15 @@ func (spot_ws *Spot_Websockets) DiffBookDepth(publicOnMessage func(diffBookDepth
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var diffBookDepth *SpotWS_DiffBookDepth
 		err := json.Unmarshal(msg, &diffBookDepth)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(diffBookDepth)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1208,7 +1410,7 @@ func (*Spot_Websockets) CreateSocket(streams []string, isCombined bool) (*Spot_W
 		return nil, err
 	}
 
-	socket.privateMessageValidator = func(msg []byte) (isPrivate bool, Id string) {
+	socket.setPrivateMessageValidator(func(msg []byte) (isPrivate bool, Id string) {
 
 		if len(msg) > 0 && msg[0] == '[' {
 			return false, ""
@@ -1227,107 +1429,66 @@ func (*Spot_Websockets) CreateSocket(streams []string, isCombined bool) (*Spot_W
 		}
 
 		return true, privateMessage.Id
-	}
+	})
 
 	ws := &Spot_Websocket{
-		Websocket:       socket,
-		Conn:            socket.Conn,
-		BaseURL:         baseURL,
-		pendingRequests: make(map[string]chan []byte),
+		Websocket: socket,
+		BaseURL:   baseURL,
 	}
 
 	return ws, nil
 }
 
-func (spot_ws *Spot_Websocket) createRequestObject() map[string]interface{} {
-	requestObj := make(map[string]interface{})
-
-	requestObj["id"] = uuid.New().String()
-	return requestObj
-}
-
 type SpotWS_ListSubscriptions_Response struct {
 	Id     string   `json:"id"`
 	Result []string `json:"result"`
 }
 
 func (spot_ws *Spot_Websocket) ListSubscriptions(timeout_sec ...int) (resp *SpotWS_ListSubscriptions_Response, hasTimedOut bool, err *Error) {
-	requestObj := spot_ws.createRequestObject()
-	requestObj["method"] = "LIST_SUBSCRIPTIONS"
-	data, timedOut, err := spot_ws.Websocket.SendRequest_sync(requestObj, timeout_sec[0])
-	if err != nil || timedOut {
+	response, timedOut, err := spot_ws.Websocket.streamRequest("LIST_SUBSCRIPTIONS", nil, timeout_sec...)
+	if err != nil {
 		return nil, timedOut, err
 	}
 
-	var response SpotWS_ListSubscriptions_Response
-	unmarshallErr := json.Unmarshal(data, &response)
-	if unmarshallErr != nil {
-		return nil, false, LocalError(ERROR_PROCESSING_ERR, unmarshallErr.Error())
-	}
-
-	return &response, false, nil
+	return &SpotWS_ListSubscriptions_Response{Id: response.Id, Result: response.Result}, false, nil
 }
 
 type SpotWS_Subscribe_Response struct {
 	Id string `json:"id"`
 }
 
+// Each call is a single message, pass every stream at once instead of calling it in a loop
+//
+// Messages beyond Constants.Websocket.MAX_OUTGOING_MESSAGES_PER_SECOND wait for their turn, the timeout starts once the message is sent
+//
+// The streams are only added to the socket once Binance accepts them, a rejection is returned as an error
 func (spot_ws *Spot_Websocket) Subscribe(stream ...string) (resp *SpotWS_Subscribe_Response, hasTimedOut bool, err *Error) {
-	requestObj := spot_ws.createRequestObject()
-	requestObj["method"] = "SUBSCRIBE"
-	requestObj["params"] = stream
-	data, timedOut, err := spot_ws.Websocket.SendRequest_sync(requestObj)
-	if err != nil || timedOut {
+	Id, timedOut, err := spot_ws.Websocket.updateSubscriptions("SUBSCRIBE", stream)
+	if err != nil {
 		return nil, timedOut, err
 	}
 
-	var response SpotWS_Subscribe_Response
-	unmarshallErr := json.Unmarshal(data, &response)
-	if unmarshallErr != nil {
-		return nil, false, LocalError(ERROR_PROCESSING_ERR, unmarshallErr.Error())
-	}
-
-	spot_ws.Websocket.Streams = append(spot_ws.Websocket.Streams, stream...)
-
 	LOG_WS_VERBOSE("Successfully Subscribed to", stream)
 
-	return &response, false, nil
+	return &SpotWS_Subscribe_Response{Id: Id}, false, nil
 }
 
 type SpotWS_Unsubscribe_Response struct {
 	Id string `json:"id"`
 }
 
+// Each call is a single message, pass every stream at once instead of calling it in a loop
+//
+// Messages beyond Constants.Websocket.MAX_OUTGOING_MESSAGES_PER_SECOND wait for their turn, the timeout starts once the message is sent
+//
+// The streams are only removed from the socket once Binance accepts it, a rejection is returned as an error
 func (spot_ws *Spot_Websocket) Unsubscribe(stream ...string) (resp *SpotWS_Unsubscribe_Response, hasTimedOut bool, err *Error) {
-	requestObj := spot_ws.createRequestObject()
-	requestObj["method"] = "UNSUBSCRIBE"
-	requestObj["params"] = stream
-	data, timedOut, err := spot_ws.Websocket.SendRequest_sync(requestObj)
-	if err != nil || timedOut {
+	Id, timedOut, err := spot_ws.Websocket.updateSubscriptions("UNSUBSCRIBE", stream)
+	if err != nil {
 		return nil, timedOut, err
 	}
 
-	var response SpotWS_Unsubscribe_Response
-	unmarshallErr := json.Unmarshal(data, &response)
-	if unmarshallErr != nil {
-		return nil, false, LocalError(ERROR_PROCESSING_ERR, unmarshallErr.Error())
-	}
-
-	// Filter out the streams to remove from spot_ws.Websocket.Streams
-	streamMap := make(map[string]bool)
-	for _, s := range stream {
-		streamMap[s] = true
-	}
-
-	var updatedStreams []string
-	for _, existingStream := range spot_ws.Websocket.Streams {
-		if !streamMap[existingStream] {
-			updatedStreams = append(updatedStreams, existingStream)
-		}
-	}
-	spot_ws.Websocket.Streams = updatedStreams
-
 	LOG_WS_VERBOSE("Successfully Unsubscribed from", stream)
 
-	return &response, false, nil
+	return &SpotWS_Unsubscribe_Response{Id: Id}, false, nil
 }
@@ -0,0 +1,214 @@
+package Binance
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// # Reconnection backfill
+//
+// Keeps the last item delivered on each stream (a symbol, or a symbol and interval) and,
+// once the socket reconnects, fetches what was missed through the REST API.
+//
+// Fetched items are delivered to the same listener before the live messages received meanwhile,
+// the ones already delivered are dropped, so that every stream stays in order.
+// They are delivered by the socket's dispatch goroutine, like the live messages, and the streams that could not be fetched are reported to its error listener.
+type streamBackfill[T any] struct {
+	websocket *Websocket
+
+	deliver func(item T)
+	// Identifies the item's stream
+	key func(item T) string
+	// Increasing inside a stream, the trade's id or the kline's open time
+	sequence func(item T) int64
+	// Klines are updated with the same open time until they close
+	allowRepeat bool
+	// Returns what followed "last" on its stream, in order, at most BACKFILL_MAX_REQUESTS requests are made
+	fetch func(last T) ([]T, *Error)
+
+	enabled atomic.Bool
+
+	mu   sync.Mutex
+	last map[string]T
+	// Live messages are held from the disconnection until the backfill is over, or until the socket is closed
+	holding bool
+	held    []T
+	// Reconnections waiting for their backfill, only the last one delivers the held messages
+	pending int
+
+	// Backfills run one at a time, each one starting from the last delivered items
+	runMu sync.Mutex
+}
+
+func (backfill *streamBackfill[T]) attach(websocket *Websocket) {
+	backfill.websocket = websocket
+	backfill.last = make(map[string]T)
+
+	websocket.setBeforeReconnect(func() {
+		if !backfill.enabled.Load() {
+			return
+		}
+
+		backfill.mu.Lock()
+		backfill.holding = true
+		backfill.mu.Unlock()
+	})
+
+	websocket.setAfterReconnect(func() {
+		backfill.mu.Lock()
+		if !backfill.holding {
+			backfill.mu.Unlock()
+			return
+		}
+		backfill.pending++
+		backfill.mu.Unlock()
+
+		websocket.goroutine(backfill.run)
+	})
+
+	// A socket giving up on reconnecting never calls afterReconnect
+	websocket.goroutine(func() {
+		<-websocket.closing
+		backfill.reset()
+	})
+}
+
+// Drops the held messages once the socket is closed, they won't be delivered
+func (backfill *streamBackfill[T]) reset() {
+	backfill.mu.Lock()
+	defer backfill.mu.Unlock()
+
+	backfill.holding = false
+	backfill.held = nil
+	backfill.pending = 0
+}
+
+// nil on the sockets sharing their type without backfilling (i.e: Delivery's)
+func (backfill *streamBackfill[T]) setEnabled(enabled bool) {
+	if backfill == nil {
+		LOG_WS_ERRORS("[BACKFILL] Backfilling isn't supported on this socket")
+		return
+	}
+	backfill.enabled.Store(enabled)
+}
+
+func (backfill *streamBackfill[T]) onMessage(item T) {
+	backfill.mu.Lock()
+	if backfill.holding {
+		backfill.held = append(backfill.held, item)
+		backfill.mu.Unlock()
+		return
+	}
+
+	isNew := true
+	if backfill.enabled.Load() {
+		isNew = backfill.advance(item)
+	}
+	backfill.mu.Unlock()
+
+	if isNew {
+		backfill.deliver(item)
+	}
+}
+
+// Records "item" as its stream's last one, returns false if it was already delivered
+//
+// "mu" must be held
+func (backfill *streamBackfill[T]) advance(item T) bool {
+	key := backfill.key(item)
+
+	if last, exists := backfill.last[key]; exists {
+		sequence, lastSequence := backfill.sequence(item), backfill.sequence(last)
+		if sequence < lastSequence || (sequence == lastSequence && !backfill.allowRepeat) {
+			return false
+		}
+	}
+
+	backfill.last[key] = item
+	return true
+}
+
+func (backfill *streamBackfill[T]) run() {
+	backfill.runMu.Lock()
+	defer backfill.runMu.Unlock()
+
+	backfill.mu.Lock()
+	lastItems := make([]T, 0, len(backfill.last))
+	for _, last := range backfill.last {
+		lastItems = append(lastItems, last)
+	}
+	backfill.mu.Unlock()
+
+	for _, last := range lastItems {
+		items, err := backfill.fetch(last)
+
+		// The fetched items are still delivered, the gap is only partly filled
+		isOpen := backfill.websocket.runOnDispatch(func() {
+			if err != nil {
+				backfill.websocket.backfillError(backfill.key(last), err)
+			}
+			backfill.deliverAll(items)
+		})
+		if !isOpen {
+			return
+		}
+	}
+
+	backfill.mu.Lock()
+	backfill.pending--
+	backfill.mu.Unlock()
+
+	backfill.websocket.runOnDispatch(backfill.release)
+}
+
+// Delivers the held messages, unless another reconnection's backfill is pending
+//
+// Called by the dispatch goroutine, so that no live message is received meanwhile
+func (backfill *streamBackfill[T]) release() {
+	backfill.mu.Lock()
+	if backfill.pending != 0 {
+		backfill.mu.Unlock()
+		return
+	}
+	held := backfill.held
+	backfill.held = nil
+	backfill.holding = false
+	backfill.mu.Unlock()
+
+	backfill.deliverAll(held)
+}
+
+// Delivers the items that weren't delivered yet, in order
+func (backfill *streamBackfill[T]) deliverAll(items []T) {
+	for _, item := range items {
+		backfill.mu.Lock()
+		isNew := backfill.advance(item)
+		backfill.mu.Unlock()
+
+		if isNew {
+			backfill.deliver(item)
+		}
+	}
+}
+
+// Fetches pages of up to "limit" items starting at "from" until a page isn't full
+//
+// "next" returns the next page's start from the last item fetched
+func fetchPages[T any](limit int, fetchPage func(from int64) ([]T, *Error), from int64, next func(item T) int64) ([]T, *Error) {
+	var items []T
+	for range Constants.Websocket.BACKFILL_MAX_REQUESTS {
+		page, err := fetchPage(from)
+		if err != nil {
+			return items, err
+		}
+		items = append(items, page...)
+
+		if len(page) < limit {
+			return items, nil
+		}
+		from = next(page[len(page)-1])
+	}
+
+	return items, LocalError(DATA_NOT_FOUND_ERR, fmt.Sprintf("Stopped after %d requests, the rest of the gap is missing", Constants.Websocket.BACKFILL_MAX_REQUESTS))
+}
@@ -0,0 +1,205 @@
+package Binance
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ws "github.com/gorilla/websocket"
+)
+
+type testBackfillTrade struct {
+	Symbol string `json:"s"`
+	Id     int64  `json:"a"`
+}
+
+// Creates a backfilled socket whose server sends connectionTrades[n] on its nth connection, the later connections are refused
+//
+// "fetch" is the backfill's, the socket is closed once the test ends.
+func newTestBackfillSocket(t *testing.T, connectionTrades [][]int64, fetch func(last *testBackfillTrade) ([]*testBackfillTrade, *Error)) (*Websocket, *streamBackfill[*testBackfillTrade], func() []int64) {
+	var dialed atomic.Int64
+	upgrader := ws.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connection := int(dialed.Add(1)) - 1
+		if connection >= len(connectionTrades) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for _, tradeId := range connectionTrades[connection] {
+			if conn.WriteMessage(ws.TextMessage, []byte(fmt.Sprintf(`{"s":"BTCUSDT","a":%d}`, tradeId))) != nil {
+				return
+			}
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	socket, err := CreateSocket("ws"+strings.TrimPrefix(server.URL, "http")+"/", []string{"btcusdt@aggTrade"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	socket.SetReconnectPolicy(Websocket_ReconnectPolicy{MaxAttempts: 1, MinDelay_ms: 1, MaxDelay_ms: 1})
+	t.Cleanup(func() {
+		if socket.State() != Constants.WebsocketStates.CLOSED {
+			socket.Close()
+		}
+		socket.routines.Wait()
+	})
+
+	var mu sync.Mutex
+	var delivered []int64
+	var inListener atomic.Int32
+	backfill := &streamBackfill[*testBackfillTrade]{
+		deliver: func(trade *testBackfillTrade) {
+			if inListener.Add(1) != 1 {
+				t.Error("a trade was delivered during another one")
+			}
+			defer inListener.Add(-1)
+
+			mu.Lock()
+			defer mu.Unlock()
+			delivered = append(delivered, trade.Id)
+		},
+		key:      func(trade *testBackfillTrade) string { return trade.Symbol },
+		sequence: func(trade *testBackfillTrade) int64 { return trade.Id },
+		fetch:    fetch,
+	}
+	backfill.attach(socket)
+	backfill.setEnabled(true)
+
+	socket.setStreamDecoder(func(stream string, msg []byte) {
+		var trade testBackfillTrade
+		if err := json.Unmarshal(msg, &trade); err != nil {
+			socket.decodeError(stream, msg, err)
+			return
+		}
+		backfill.onMessage(&trade)
+	})
+
+	return socket, backfill, func() []int64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]int64(nil), delivered...)
+	}
+}
+
+// Waits up to 5 seconds for "condition"
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); !condition(); {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (backfill *streamBackfill[T]) heldCount() int {
+	backfill.mu.Lock()
+	defer backfill.mu.Unlock()
+	return len(backfill.held)
+}
+
+func TestStreamBackfillDeliversInOrderAndReportsErrors(t *testing.T) {
+	fetched := make(chan struct{})
+	fetch := func(last *testBackfillTrade) ([]*testBackfillTrade, *Error) {
+		<-fetched
+		// Partly fetched, "4" and "5" were held meanwhile
+		return []*testBackfillTrade{{Symbol: last.Symbol, Id: 3}, {Symbol: last.Symbol, Id: 4}}, LocalError(HTTP_REQUEST_ERR, "the second page could not be fetched")
+	}
+	socket, backfill, delivered := newTestBackfillSocket(t, [][]int64{{1, 2}, {4, 5, 6}}, fetch)
+
+	var errs []*WebsocketDecodeError
+	var errsMu sync.Mutex
+	socket.SetErrorListener(func(err *WebsocketDecodeError) {
+		errsMu.Lock()
+		defer errsMu.Unlock()
+		errs = append(errs, err)
+	})
+
+	waitFor(t, "the first trades", func() bool { return len(delivered()) == 2 })
+
+	socket.Reconnect()
+	waitFor(t, "the live trades to be held", func() bool { return backfill.heldCount() == 3 })
+	if got := delivered(); len(got) != 2 {
+		t.Fatalf("expected the live trades to be held until the backfill is over, got %v", got)
+	}
+
+	close(fetched)
+	waitFor(t, "the held trades", func() bool { return len(delivered()) == 6 })
+
+	if got := fmt.Sprint(delivered()); got != "[1 2 3 4 5 6]" {
+		t.Fatalf("expected [1 2 3 4 5 6], got %s", got)
+	}
+
+	errsMu.Lock()
+	defer errsMu.Unlock()
+	if len(errs) != 1 || !errs[0].IsBackfill || errs[0].Stream != "BTCUSDT" {
+		t.Fatalf("expected the failed fetch of BTCUSDT to be reported once, got %v", errs)
+	}
+}
+
+func TestStreamBackfillDropsHeldMessagesOnClose(t *testing.T) {
+	fetching := make(chan struct{})
+	closed := make(chan struct{})
+	fetch := func(last *testBackfillTrade) ([]*testBackfillTrade, *Error) {
+		close(fetching)
+		<-closed
+		return []*testBackfillTrade{{Symbol: last.Symbol, Id: 2}}, nil
+	}
+	socket, backfill, delivered := newTestBackfillSocket(t, [][]int64{{1}, {3, 4}}, fetch)
+
+	waitFor(t, "the first trade", func() bool { return len(delivered()) == 1 })
+
+	socket.Reconnect()
+	<-fetching
+	waitFor(t, "the live trades to be held", func() bool { return backfill.heldCount() == 2 })
+
+	socket.Close()
+	close(closed)
+	socket.routines.Wait()
+
+	backfill.mu.Lock()
+	defer backfill.mu.Unlock()
+	if backfill.holding || backfill.held != nil || backfill.pending != 0 {
+		t.Fatalf("expected the backfill to be reset, holding: %v, held: %d, pending: %d", backfill.holding, len(backfill.held), backfill.pending)
+	}
+}
+
+func TestStreamBackfillResetsWhenGivingUp(t *testing.T) {
+	fetch := func(last *testBackfillTrade) ([]*testBackfillTrade, *Error) {
+		t.Error("nothing should be fetched without a reconnection")
+		return nil, nil
+	}
+	// The reconnection is refused
+	socket, backfill, delivered := newTestBackfillSocket(t, [][]int64{{1}}, fetch)
+
+	waitFor(t, "the first trade", func() bool { return len(delivered()) == 1 })
+
+	socket.Reconnect()
+	if state := socket.State(); state != Constants.WebsocketStates.CLOSED {
+		t.Fatalf("expected the socket to give up reconnecting, its state is '%s'", state)
+	}
+	socket.routines.Wait()
+
+	backfill.mu.Lock()
+	defer backfill.mu.Unlock()
+	if backfill.holding {
+		t.Fatal("expected the backfill to stop holding the live messages once the socket gave up")
+	}
+}
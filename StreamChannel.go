@@ -0,0 +1,283 @@
+package Binance
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// What a StreamChannel does with a message received while its buffer is full
+type StreamOverflowPolicy int
+
+const (
+	// Waits for the consumer, the socket's reader waits with it (Binance drops connections that aren't read fast enough)
+	OVERFLOW_BLOCK StreamOverflowPolicy = iota
+	// Drops the oldest buffered message to make room for the new one
+	OVERFLOW_DROP_OLDEST
+	// Drops the new message
+	OVERFLOW_DROP_NEWEST
+	// Keeps only the latest message of each key (the symbol by default) while they wait in the buffer,
+	// the oldest key is dropped if the buffer is full of different keys
+	OVERFLOW_COALESCE
+)
+
+type StreamChannel_Params[T any] struct {
+	// Messages waiting for the consumer, default 1024
+	BufferSize int
+	// Default OVERFLOW_BLOCK
+	Overflow StreamOverflowPolicy
+	// OVERFLOW_COALESCE only, defaults to the message's "Symbol" field
+	Key func(msg T) string
+}
+
+// # Channel based alternative to the stream listeners
+//
+// "Send" is the listener to give to any stream, i.e: client.Futures.Websockets.AggTrade(channel.Send, "BTCUSDT"),
+// messages are buffered and received from "C()" so that a slow consumer doesn't stall the socket's reader.
+//
+// See OpenStreamChannel() to open a stream and its channel at once.
+type StreamChannel[T any] struct {
+	bufferSize int
+	overflow   StreamOverflowPolicy
+	key        func(msg T) string
+
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	queue    []T
+	// OVERFLOW_COALESCE only, replaces "queue": the keys in order of arrival and their latest message
+	keys   []string
+	latest map[string]T
+	closed bool
+
+	out  chan T
+	done chan struct{}
+
+	dropped   atomic.Uint64
+	delivered atomic.Uint64
+}
+
+func NewStreamChannel[T any](opt_params ...StreamChannel_Params[T]) *StreamChannel[T] {
+	channel := &StreamChannel[T]{
+		bufferSize: 1024,
+		overflow:   OVERFLOW_BLOCK,
+		out:        make(chan T),
+		done:       make(chan struct{}),
+	}
+	channel.notEmpty = sync.NewCond(&channel.mu)
+	channel.notFull = sync.NewCond(&channel.mu)
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if params.BufferSize > 0 {
+			channel.bufferSize = params.BufferSize
+		}
+		channel.overflow = params.Overflow
+		channel.key = params.Key
+	}
+
+	if channel.overflow == OVERFLOW_COALESCE {
+		if channel.key == nil {
+			channel.key = symbolKey[T]()
+		}
+		if channel.key == nil {
+			LOG_WS_ERRORS("[StreamChannel] The messages have no \"Symbol\" field to coalesce, dropping the oldest instead")
+			channel.overflow = OVERFLOW_DROP_OLDEST
+		} else {
+			channel.latest = make(map[string]T)
+		}
+	}
+
+	go channel.pump()
+
+	return channel
+}
+
+// # Opens a stream delivering to a new StreamChannel
+//
+// "stream" is any of the stream helpers taking a listener and its streams, i.e:
+//
+//	socket, channel, err := Binance.OpenStreamChannel(client.Futures.Websockets.AggTrade, Binance.StreamChannel_Params[*Binance.FuturesWS_AggTrade]{Overflow: Binance.OVERFLOW_DROP_OLDEST}, "BTCUSDT")
+//
+// Closing the socket doesn't close the channel, both must be closed.
+func OpenStreamChannel[T any, Socket any, Identifier any](stream func(publicOnMessage func(msg T), identifiers ...Identifier) (Socket, *Error), params StreamChannel_Params[T], identifiers ...Identifier) (Socket, *StreamChannel[T], *Error) {
+	channel := NewStreamChannel(params)
+
+	socket, err := stream(channel.Send, identifiers...)
+	if err != nil {
+		channel.Close()
+		var noSocket Socket
+		return noSocket, nil, err
+	}
+
+	return socket, channel, nil
+}
+
+// Receives the buffered messages, closed once the StreamChannel is closed
+func (channel *StreamChannel[T]) C() <-chan T {
+	return channel.out
+}
+
+// The stream's listener, buffers "msg" according to the overflow policy
+func (channel *StreamChannel[T]) Send(msg T) {
+	channel.mu.Lock()
+	defer channel.mu.Unlock()
+
+	if channel.closed {
+		return
+	}
+
+	if channel.overflow == OVERFLOW_COALESCE {
+		key := channel.key(msg)
+		if _, exists := channel.latest[key]; exists {
+			channel.latest[key] = msg
+			channel.dropped.Add(1)
+			return
+		}
+		if len(channel.keys) >= channel.bufferSize {
+			channel.popFront()
+			channel.dropped.Add(1)
+		}
+		channel.keys = append(channel.keys, key)
+		channel.latest[key] = msg
+		channel.notEmpty.Signal()
+		return
+	}
+
+	if len(channel.queue) >= channel.bufferSize {
+		switch channel.overflow {
+		case OVERFLOW_DROP_NEWEST:
+			channel.dropped.Add(1)
+			return
+		case OVERFLOW_DROP_OLDEST:
+			channel.popFront()
+			channel.dropped.Add(1)
+		default:
+			for len(channel.queue) >= channel.bufferSize && !channel.closed {
+				channel.notFull.Wait()
+			}
+			if channel.closed {
+				return
+			}
+		}
+	}
+
+	channel.queue = append(channel.queue, msg)
+	channel.notEmpty.Signal()
+}
+
+// "mu" must be held
+func (channel *StreamChannel[T]) length() int {
+	if channel.overflow == OVERFLOW_COALESCE {
+		return len(channel.keys)
+	}
+	return len(channel.queue)
+}
+
+// "mu" must be held and the buffer not empty
+func (channel *StreamChannel[T]) popFront() T {
+	if channel.overflow == OVERFLOW_COALESCE {
+		key := channel.keys[0]
+		channel.keys = channel.keys[1:]
+
+		msg := channel.latest[key]
+		delete(channel.latest, key)
+		return msg
+	}
+
+	msg := channel.queue[0]
+
+	var zero T
+	channel.queue[0] = zero
+	channel.queue = channel.queue[1:]
+
+	return msg
+}
+
+// Hands the buffered messages to "out" one at a time
+func (channel *StreamChannel[T]) pump() {
+	defer close(channel.out)
+
+	for {
+		channel.mu.Lock()
+		for channel.length() == 0 && !channel.closed {
+			channel.notEmpty.Wait()
+		}
+		if channel.closed {
+			channel.mu.Unlock()
+			return
+		}
+		msg := channel.popFront()
+		channel.notFull.Signal()
+		channel.mu.Unlock()
+
+		select {
+		case channel.out <- msg:
+			channel.delivered.Add(1)
+		case <-channel.done:
+			return
+		}
+	}
+}
+
+// Stops the channel, "C()" is closed and the buffered messages are discarded
+func (channel *StreamChannel[T]) Close() {
+	channel.mu.Lock()
+	defer channel.mu.Unlock()
+
+	if channel.closed {
+		return
+	}
+	channel.closed = true
+	close(channel.done)
+
+	channel.notEmpty.Broadcast()
+	channel.notFull.Broadcast()
+}
+
+// Messages dropped (or replaced when coalescing) because the buffer was full
+func (channel *StreamChannel[T]) Dropped() uint64 {
+	return channel.dropped.Load()
+}
+
+// Messages received from "C()"
+func (channel *StreamChannel[T]) Delivered() uint64 {
+	return channel.delivered.Load()
+}
+
+// Messages waiting in the buffer
+func (channel *StreamChannel[T]) Len() int {
+	channel.mu.Lock()
+	defer channel.mu.Unlock()
+
+	return channel.length()
+}
+
+// Returns a key reading T's "Symbol" string field, nil if T has none
+func symbolKey[T any]() func(msg T) string {
+	msgType := reflect.TypeFor[T]()
+
+	isPointer := msgType.Kind() == reflect.Pointer
+	if isPointer {
+		msgType = msgType.Elem()
+	}
+	if msgType.Kind() != reflect.Struct {
+		return nil
+	}
+
+	field, exists := msgType.FieldByName("Symbol")
+	if !exists || field.Type.Kind() != reflect.String {
+		return nil
+	}
+
+	return func(msg T) string {
+		value := reflect.ValueOf(msg)
+		if isPointer {
+			if value.IsNil() {
+				return ""
+			}
+			value = value.Elem()
+		}
+		return value.FieldByIndex(field.Index).String()
+	}
+}
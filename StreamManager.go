@@ -0,0 +1,753 @@
+package Binance
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	ws "github.com/gorilla/websocket"
+)
+
+// # Stream manager
+//
+// Accepts any number of streams and spreads them over as many combined connections as needed,
+// each one holding at most "MaxStreamsPerSocket" streams in a URL no longer than "MaxURLLength".
+//
+// Subscriptions fill the open connections first and only dial new ones for the streams left,
+// unsubscriptions close the connections left empty, and move the streams of the emptiest connection into another one when they fit.
+// A moved stream is subscribed on its new connection before its old one is closed, both are read meanwhile and handed over by the position of their events
+// like a 24 hour rotation (see websocketRotation), so its messages are still received once and in order.
+//
+// Every connection reconnects on its own, the messages of all of them reach the listeners from a single goroutine, one at a time.
+//
+// Messages can also be decoded into their types by stream kind, see HandleStream().
+type StreamManager struct {
+	baseURL      string
+	createSocket func(streams []string) (*Websocket, *Error)
+
+	maxStreams   int
+	maxURLLength int
+
+	// Serializes Subscribe, Unsubscribe and Close
+	opMu sync.Mutex
+
+	mu           sync.RWMutex
+	shards       []*streamShard
+	streamShards map[string]*streamShard
+	onMessage    func(stream string, msg []byte)
+	onError      func(err *WebsocketDecodeError)
+	onDrift      func(drift *SubscriptionDrift)
+	// 0 while the connections aren't reconciled, see StartReconciliation()
+	reconcileInterval int64
+	// Typed handlers by stream kind, see HandleStream()
+	handlers map[string]func(stream string, msg []byte)
+	closed   bool
+
+	// Messages and decode errors of every connection, see dispatchLoop()
+	frames chan streamManagerFrame
+	// Closed once the manager is closed, stops the dispatch goroutine
+	closing chan struct{}
+
+	// Streams being moved by rebalance(), only filtered until their new connection delivered each of them
+	moving map[string]*streamMove
+}
+
+// A message or decode error of one of the connections
+type streamManagerFrame struct {
+	shard  *streamShard
+	stream string
+	msg    []byte
+	err    *WebsocketDecodeError
+
+	// Only set, without a message, once the old connection of "moved" was closed: the new one's held messages are delivered
+	moved *streamMove
+}
+
+// Streams moved into "target", handed over like a 24 hour rotation whose replacement is "target"
+type streamMove struct {
+	target   *streamShard
+	rotation *websocketRotation
+}
+
+type streamShard struct {
+	socket  *Websocket
+	streams map[string]bool
+	load    streamLoad
+}
+
+// Number of streams and length of the URL holding them
+type streamLoad struct {
+	count     int
+	urlLength int
+}
+
+type StreamManager_Params struct {
+	// Default Constants.Websocket.MAX_STREAMS_PER_SOCKET
+	MaxStreamsPerSocket int
+	// Default Constants.Websocket.MAX_URL_LENGTH
+	MaxURLLength int
+}
+
+func newStreamManager(baseURL string, createSocket func(streams []string) (*Websocket, *Error), opt_params []StreamManager_Params) *StreamManager {
+	manager := &StreamManager{
+		baseURL:      baseURL,
+		createSocket: createSocket,
+		maxStreams:   int(Constants.Websocket.MAX_STREAMS_PER_SOCKET),
+		maxURLLength: int(Constants.Websocket.MAX_URL_LENGTH),
+		streamShards: make(map[string]*streamShard),
+		handlers:     make(map[string]func(stream string, msg []byte)),
+		frames:       make(chan streamManagerFrame),
+		closing:      make(chan struct{}),
+		moving:       make(map[string]*streamMove),
+	}
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.MaxStreamsPerSocket) {
+			manager.maxStreams = params.MaxStreamsPerSocket
+		}
+		if IsDifferentFromDefault(params.MaxURLLength) {
+			manager.maxURLLength = params.MaxURLLength
+		}
+	}
+
+	go manager.dispatchLoop()
+
+	return manager
+}
+
+func (manager *StreamManager) emptyLoad() streamLoad {
+	// The first stream has no leading "/"
+	return streamLoad{urlLength: len(manager.baseURL) + len(CreateQueryStringWS(nil, true)) - 1}
+}
+
+func (manager *StreamManager) fits(load streamLoad, stream string) bool {
+	return load.count < manager.maxStreams && load.urlLength+1+len(stream) <= manager.maxURLLength
+}
+
+func (load streamLoad) add(stream string) streamLoad {
+	return streamLoad{count: load.count + 1, urlLength: load.urlLength + 1 + len(stream)}
+}
+
+func (load streamLoad) remove(stream string) streamLoad {
+	return streamLoad{count: load.count - 1, urlLength: load.urlLength - 1 - len(stream)}
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// Called with the stream's name and its data, from the manager's dispatch goroutine
+func (manager *StreamManager) SetMessageListener(f func(stream string, msg []byte)) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	manager.onMessage = f
+}
+
+// Receives the messages of every connection that could not be decoded, they are logged otherwise
+func (manager *StreamManager) SetErrorListener(f func(err *WebsocketDecodeError)) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	manager.onError = f
+}
+
+// Called when the reconciliation of a connection finds its subscriptions drifted
+func (manager *StreamManager) SetDriftListener(f func(drift *SubscriptionDrift)) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	manager.onDrift = f
+}
+
+func (manager *StreamManager) reportDrift(drift *SubscriptionDrift) {
+	manager.mu.RLock()
+	onDrift := manager.onDrift
+	manager.mu.RUnlock()
+
+	if onDrift != nil {
+		onDrift(drift)
+	}
+}
+
+// # Reconciles the subscriptions of every connection, see Websocket.StartReconciliation()
+//
+// Applies to the connections opened afterwards too.
+func (manager *StreamManager) StartReconciliation(interval_sec ...int64) {
+	interval := Constants.Websocket.RECONCILE_INTERVAL_SEC
+	if len(interval_sec) != 0 && interval_sec[0] > 0 {
+		interval = interval_sec[0]
+	}
+
+	manager.mu.Lock()
+	manager.reconcileInterval = interval
+	shards := slices.Clone(manager.shards)
+	manager.mu.Unlock()
+
+	for _, shard := range shards {
+		shard.socket.StartReconciliation(interval)
+	}
+}
+
+func (manager *StreamManager) StopReconciliation() {
+	manager.mu.Lock()
+	manager.reconcileInterval = 0
+	shards := slices.Clone(manager.shards)
+	manager.mu.Unlock()
+
+	for _, shard := range shards {
+		shard.socket.StopReconciliation()
+	}
+}
+
+func (manager *StreamManager) reportError(err *WebsocketDecodeError) {
+	manager.mu.RLock()
+	onError := manager.onError
+	manager.mu.RUnlock()
+
+	if onError == nil {
+		LOG_WS_ERRORS("[STREAM MANAGER] Could not decode a message:", err.Error())
+		return
+	}
+	onError(err)
+}
+
+// # Routes the messages of a stream kind to a typed handler
+//
+// "kind" is the stream's name without its symbol, the most specific kind registered handles the stream:
+// "btcusdt@kline_1m" is handled by "kline_1m" if registered, otherwise by "kline",
+// "btcusdt@depth20@100ms" by "depth20@100ms" or "depth20", and "!markPrice@arr@1s" by "!markPrice@arr@1s", "!markPrice@arr" or "!markPrice".
+//
+// Each message is decoded into a new T, i.e:
+//
+//	Binance.HandleStream(manager, "aggTrade", func(stream string, aggTrade *Binance.FuturesWS_AggTrade) {...})
+//	Binance.HandleStream(manager, "!markPrice@arr", func(stream string, markPrices []*Binance.FuturesWS_MarkPrice) {...})
+//
+// Messages that can't be decoded go to the error listener, the message listener still receives every message.
+func HandleStream[T any](manager *StreamManager, kind string, handler func(stream string, msg T)) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	manager.handlers[kind] = func(stream string, msg []byte) {
+		var decoded T
+		err := json.Unmarshal(msg, &decoded)
+		if err != nil {
+			manager.reportError(&WebsocketDecodeError{
+				Err:    LocalError(PARSING_ERR, err.Error()),
+				Stream: stream,
+				Raw:    msg,
+			})
+			return
+		}
+		handler(stream, decoded)
+	}
+}
+
+// Removes the typed handler of "kind", see HandleStream()
+func (manager *StreamManager) RemoveStreamHandler(kind string) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	delete(manager.handlers, kind)
+}
+
+// Returns the handler of the most specific kind registered for "stream", nil if there is none
+//
+// "mu" must be held
+func (manager *StreamManager) streamHandler(stream string) func(stream string, msg []byte) {
+	if len(manager.handlers) == 0 {
+		return nil
+	}
+
+	// "!" streams have no symbol, i.e: "!bookTicker"
+	kind := stream
+	if !strings.HasPrefix(stream, "!") {
+		var found bool
+		_, kind, found = strings.Cut(stream, "@")
+		if !found {
+			return nil
+		}
+	}
+
+	for {
+		if handler, exists := manager.handlers[kind]; exists {
+			return handler
+		}
+
+		cut := strings.LastIndexAny(kind, "@_")
+		if cut <= 0 {
+			return nil
+		}
+		kind = kind[:cut]
+	}
+}
+
+// The only goroutine calling the listeners and handlers, whichever connection the messages come from
+func (manager *StreamManager) dispatchLoop() {
+	for {
+		select {
+		case frame := <-manager.frames:
+			switch {
+			case frame.err != nil:
+				manager.reportError(frame.err)
+			case frame.moved != nil:
+				frame.moved.rotation.setSwitched()
+				for _, held := range frame.moved.rotation.releaseAll() {
+					manager.dispatch(held.stream, held.data)
+				}
+				manager.endMove(frame.moved)
+			default:
+				manager.dispatchFrame(frame)
+			}
+		case <-manager.closing:
+			return
+		}
+	}
+}
+
+// Hands a frame to the dispatch goroutine, it's dropped once the manager or "closing" is closed
+func (manager *StreamManager) post(frame streamManagerFrame, closing <-chan struct{}) {
+	select {
+	case manager.frames <- frame:
+	case <-manager.closing:
+	case <-closing:
+	}
+}
+
+func (manager *StreamManager) dispatchFrame(frame streamManagerFrame) {
+	manager.mu.RLock()
+	move := manager.moving[frame.stream]
+	manager.mu.RUnlock()
+
+	if move == nil {
+		manager.dispatch(frame.stream, frame.msg)
+		return
+	}
+
+	// Both connections receive the stream while it's moved
+	for _, accepted := range move.rotation.accept(frame.shard == move.target, ws.TextMessage, frame.stream, frame.msg) {
+		manager.dispatch(accepted.stream, accepted.data)
+	}
+	manager.endMove(move)
+}
+
+// Stops filtering the streams of "move" once its old connection is closed and the new one delivered each of them
+func (manager *StreamManager) endMove(move *streamMove) {
+	if !move.rotation.isDone() {
+		return
+	}
+
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	for stream, current := range manager.moving {
+		if current == move {
+			delete(manager.moving, stream)
+		}
+	}
+}
+
+func (manager *StreamManager) dispatch(stream string, msg []byte) {
+	manager.mu.RLock()
+	onMessage := manager.onMessage
+	handler := manager.streamHandler(stream)
+	manager.mu.RUnlock()
+
+	if handler != nil {
+		handler(stream, msg)
+	}
+
+	if onMessage != nil {
+		onMessage(stream, msg)
+	}
+}
+
+// Returns the subscribed streams, sorted
+func (manager *StreamManager) Streams() []string {
+	manager.mu.RLock()
+	defer manager.mu.RUnlock()
+
+	streams := make([]string, 0, len(manager.streamShards))
+	for stream := range manager.streamShards {
+		streams = append(streams, stream)
+	}
+	sort.Strings(streams)
+
+	return streams
+}
+
+// Returns the number of open connections
+func (manager *StreamManager) Connections() int {
+	manager.mu.RLock()
+	defer manager.mu.RUnlock()
+
+	return len(manager.shards)
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Subscribes to the streams, already subscribed ones are ignored
+//
+// Stops at the first error, the streams subscribed until then stay subscribed.
+func (manager *StreamManager) Subscribe(streams ...string) *Error {
+	manager.opMu.Lock()
+	defer manager.opMu.Unlock()
+
+	manager.mu.RLock()
+	closed := manager.closed
+	shards := append([]*streamShard(nil), manager.shards...)
+	var newStreams []string
+	seen := make(map[string]bool)
+	for _, stream := range streams {
+		if _, exists := manager.streamShards[stream]; !exists && !seen[stream] {
+			seen[stream] = true
+			newStreams = append(newStreams, stream)
+		}
+	}
+	manager.mu.RUnlock()
+
+	if closed {
+		return LocalError(WS_SEND_MESSAGE_ERR, "The stream manager is closed")
+	}
+
+	for _, stream := range newStreams {
+		if !manager.fits(manager.emptyLoad(), stream) {
+			return LocalError(INVALID_VALUE_ERR, fmt.Sprintf("Stream '%s' does not fit in a connection's URL", stream))
+		}
+	}
+
+	// Fill the open connections first
+	loads := make([]streamLoad, len(shards))
+	assigned := make([][]string, len(shards))
+	for i, shard := range shards {
+		loads[i] = shard.load
+	}
+
+	var rest []string
+	for _, stream := range newStreams {
+		placed := false
+		for i := range shards {
+			if manager.fits(loads[i], stream) {
+				loads[i] = loads[i].add(stream)
+				assigned[i] = append(assigned[i], stream)
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			rest = append(rest, stream)
+		}
+	}
+
+	for i, shard := range shards {
+		if len(assigned[i]) == 0 {
+			continue
+		}
+
+		err := manager.subscribeShard(shard, assigned[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	// Then dial new connections for the streams left
+	var group []string
+	load := manager.emptyLoad()
+	for _, stream := range rest {
+		if !manager.fits(load, stream) {
+			err := manager.openShard(group)
+			if err != nil {
+				return err
+			}
+			group, load = nil, manager.emptyLoad()
+		}
+		group = append(group, stream)
+		load = load.add(stream)
+	}
+	if len(group) != 0 {
+		return manager.openShard(group)
+	}
+
+	return nil
+}
+
+// # Unsubscribes from the streams, unknown ones are ignored
+func (manager *StreamManager) Unsubscribe(streams ...string) *Error {
+	manager.opMu.Lock()
+	defer manager.opMu.Unlock()
+
+	manager.mu.RLock()
+	shards := append([]*streamShard(nil), manager.shards...)
+	removed := make(map[*streamShard][]string)
+	for _, stream := range streams {
+		shard, exists := manager.streamShards[stream]
+		if exists && !slices.Contains(removed[shard], stream) {
+			removed[shard] = append(removed[shard], stream)
+		}
+	}
+	manager.mu.RUnlock()
+
+	for _, shard := range shards {
+		shardStreams := removed[shard]
+		if len(shardStreams) == 0 {
+			continue
+		}
+
+		if len(shardStreams) == len(shard.streams) {
+			manager.closeShard(shard)
+			continue
+		}
+
+		_, _, err := shard.socket.updateSubscriptions("UNSUBSCRIBE", shardStreams)
+		if err != nil {
+			return err
+		}
+
+		manager.mu.Lock()
+		for _, stream := range shardStreams {
+			delete(shard.streams, stream)
+			delete(manager.streamShards, stream)
+			delete(manager.moving, stream)
+			shard.load = shard.load.remove(stream)
+		}
+		manager.mu.Unlock()
+	}
+
+	return manager.rebalance()
+}
+
+// Moves the streams of the emptiest connection into the first other connection with room for all of them
+func (manager *StreamManager) rebalance() *Error {
+	manager.mu.RLock()
+	shards := append([]*streamShard(nil), manager.shards...)
+	manager.mu.RUnlock()
+
+	if len(shards) < 2 {
+		return nil
+	}
+
+	source := shards[0]
+	for _, shard := range shards[1:] {
+		if shard.load.count < source.load.count {
+			source = shard
+		}
+	}
+
+	manager.mu.RLock()
+	streams := make([]string, 0, len(source.streams))
+	for stream := range source.streams {
+		streams = append(streams, stream)
+	}
+	manager.mu.RUnlock()
+	sort.Strings(streams)
+
+	for _, target := range shards {
+		if target == source {
+			continue
+		}
+
+		load := target.load
+		fitsAll := true
+		for _, stream := range streams {
+			if !manager.fits(load, stream) {
+				fitsAll = false
+				break
+			}
+			load = load.add(stream)
+		}
+		if !fitsAll {
+			continue
+		}
+
+		move := &streamMove{
+			target:   target,
+			rotation: &websocketRotation{streams: make(map[string]*rotationStream)},
+		}
+		manager.mu.Lock()
+		for _, stream := range streams {
+			manager.moving[stream] = move
+		}
+		manager.mu.Unlock()
+
+		err := manager.subscribeShard(target, streams)
+		if err != nil {
+			// The old connection keeps the streams
+			manager.mu.Lock()
+			for _, stream := range streams {
+				if manager.moving[stream] == move {
+					delete(manager.moving, stream)
+				}
+			}
+			manager.mu.Unlock()
+			return err
+		}
+		manager.closeShard(source)
+
+		// From its own goroutine, a listener may be unsubscribing from the dispatch goroutine
+		go manager.post(streamManagerFrame{moved: move}, nil)
+		return nil
+	}
+
+	return nil
+}
+
+// Stops every connection, the manager can't be used afterwards
+func (manager *StreamManager) Close() {
+	manager.opMu.Lock()
+	defer manager.opMu.Unlock()
+
+	manager.mu.Lock()
+	if !manager.closed {
+		close(manager.closing)
+	}
+	manager.closed = true
+	shards := manager.shards
+	manager.shards = nil
+	manager.streamShards = make(map[string]*streamShard)
+	manager.moving = make(map[string]*streamMove)
+	manager.mu.Unlock()
+
+	for _, shard := range shards {
+		shard.socket.Close()
+	}
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+func (manager *StreamManager) openShard(streams []string) *Error {
+	socket, err := manager.createSocket(streams)
+	if err != nil {
+		return err
+	}
+	shard := &streamShard{
+		socket:  socket,
+		streams: make(map[string]bool),
+		load:    manager.emptyLoad(),
+	}
+	// Dropped once the connection is closed, a moved stream's messages are then delivered by its new connection
+	socket.setStreamDecoder(func(stream string, msg []byte) {
+		manager.post(streamManagerFrame{shard: shard, stream: stream, msg: msg}, socket.closing)
+	})
+	socket.SetErrorListener(func(err *WebsocketDecodeError) {
+		manager.post(streamManagerFrame{shard: shard, err: err}, socket.closing)
+	})
+	socket.SetDriftListener(manager.reportDrift)
+	// A socket giving up on reconnecting loses its streams, they can be subscribed again afterwards
+	socket.SetCloseListener(func(code int, text string) {
+		manager.removeShard(shard)
+	})
+
+	manager.mu.Lock()
+	for _, stream := range streams {
+		shard.streams[stream] = true
+		shard.load = shard.load.add(stream)
+		manager.streamShards[stream] = shard
+	}
+	manager.shards = append(manager.shards, shard)
+	reconcileInterval := manager.reconcileInterval
+	manager.mu.Unlock()
+
+	if reconcileInterval != 0 {
+		socket.StartReconciliation(reconcileInterval)
+	}
+
+	LOG_WS_VERBOSE("[STREAM MANAGER] Opened a connection with", len(streams), "streams")
+
+	return nil
+}
+
+func (manager *StreamManager) subscribeShard(shard *streamShard, streams []string) *Error {
+	_, _, err := shard.socket.updateSubscriptions("SUBSCRIBE", streams)
+	if err != nil {
+		return err
+	}
+
+	manager.mu.Lock()
+	for _, stream := range streams {
+		shard.streams[stream] = true
+		shard.load = shard.load.add(stream)
+		manager.streamShards[stream] = shard
+	}
+	manager.mu.Unlock()
+
+	return nil
+}
+
+func (manager *StreamManager) closeShard(shard *streamShard) {
+	manager.removeShard(shard)
+	shard.socket.Close()
+
+	LOG_WS_VERBOSE("[STREAM MANAGER] Closed a connection with", len(shard.streams), "streams")
+}
+
+func (manager *StreamManager) removeShard(shard *streamShard) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	for stream := range shard.streams {
+		if manager.streamShards[stream] == shard {
+			delete(manager.streamShards, stream)
+			delete(manager.moving, stream)
+		}
+	}
+	for i, existing := range manager.shards {
+		if existing == shard {
+			manager.shards = append(manager.shards[:i], manager.shards[i+1:]...)
+			break
+		}
+	}
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Creates a sharded stream manager, see StreamManager
+//
+// A single manager can replace every typed socket, i.e:
+//
+//	manager := client.Spot.Websockets.NewStreamManager()
+//	Binance.HandleStream(manager, "bookTicker", func(stream string, bookTicker *Binance.SpotWS_BookTicker) {...})
+//	Binance.HandleStream(manager, "kline", func(stream string, candlestick *Binance.SpotWS_Candlestick_MSG) {...})
+//	manager.Subscribe("btcusdt@bookTicker", "ethusdt@bookTicker", "btcusdt@kline_1m")
+func (spot_ws *Spot_Websockets) NewStreamManager(opt_params ...StreamManager_Params) *StreamManager {
+	return newStreamManager(SPOT_Constants.Websocket.URLs[0], func(streams []string) (*Websocket, *Error) {
+		socket, err := spot_ws.CreateSocket(streams, true)
+		if err != nil {
+			return nil, err
+		}
+		return socket.Websocket, nil
+	}, opt_params)
+}
+
+// # Creates a sharded stream manager, see StreamManager
+func (futures_ws *Futures_Websockets) NewStreamManager(opt_params ...StreamManager_Params) *StreamManager {
+	return newStreamManager(FUTURES_Constants.Websocket.URLs[0], func(streams []string) (*Websocket, *Error) {
+		socket, err := futures_ws.CreateSocket(streams, true)
+		if err != nil {
+			return nil, err
+		}
+		return socket.Websocket, nil
+	}, opt_params)
+}
+
+// # Creates a sharded stream manager, see StreamManager
+func (delivery_ws *Delivery_Websockets) NewStreamManager(opt_params ...StreamManager_Params) *StreamManager {
+	return newStreamManager(DELIVERY_Constants.Websocket.URLs[0], func(streams []string) (*Websocket, *Error) {
+		socket, err := delivery_ws.CreateSocket(streams, true)
+		if err != nil {
+			return nil, err
+		}
+		return socket.Websocket, nil
+	}, opt_params)
+}
+
+// # Creates a sharded stream manager, see StreamManager
+func (options_ws *Options_Websockets) NewStreamManager(opt_params ...StreamManager_Params) *StreamManager {
+	return newStreamManager(OPTIONS_Constants.Websocket.URLs[0], func(streams []string) (*Websocket, *Error) {
+		socket, err := options_ws.CreateSocket(streams, true)
+		if err != nil {
+			return nil, err
+		}
+		return socket.Websocket, nil
+	}, opt_params)
+}
@@ -0,0 +1,299 @@
+package Binance
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ws "github.com/gorilla/websocket"
+)
+
+// Streams the same aggTrade events to every connection, for the streams it's subscribed to
+//
+// Each connection has its own constant latency, and answers the (UN)SUBSCRIBE requests.
+type testStreamServer struct {
+	mu          sync.Mutex
+	connections map[*testStreamConnection]bool
+	dialed      int
+}
+
+type testStreamConnection struct {
+	messages chan testBroadcast
+
+	mu      sync.Mutex
+	streams map[string]bool
+}
+
+func newTestStreamServer(t *testing.T) (*testStreamServer, string) {
+	server := &testStreamServer{connections: make(map[*testStreamConnection]bool)}
+	httpServer := httptest.NewServer(http.HandlerFunc(server.serve))
+	t.Cleanup(httpServer.Close)
+
+	return server, "ws" + strings.TrimPrefix(httpServer.URL, "http")
+}
+
+func (server *testStreamServer) serve(w http.ResponseWriter, r *http.Request) {
+	upgrader := ws.Upgrader{}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	connection := &testStreamConnection{
+		messages: make(chan testBroadcast, 1<<16),
+		streams:  make(map[string]bool),
+	}
+	for _, stream := range strings.Split(r.URL.Query().Get("streams"), "/") {
+		if stream != "" {
+			connection.streams[stream] = true
+		}
+	}
+
+	server.mu.Lock()
+	server.connections[connection] = true
+	// 12, 6, 0ms... so that the connections are alternately ahead and behind, the first ones behind
+	latency := time.Duration(2-server.dialed%3) * 6 * time.Millisecond
+	server.dialed++
+	server.mu.Unlock()
+	defer func() {
+		server.mu.Lock()
+		delete(server.connections, connection)
+		server.mu.Unlock()
+	}()
+
+	var writeMu sync.Mutex
+	write := func(msg string) error {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		return conn.WriteMessage(ws.TextMessage, []byte(msg))
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			method := json.Get(msg, "method").ToString()
+			var streams []string
+			json.Get(msg, "params").ToVal(&streams)
+
+			connection.mu.Lock()
+			for _, stream := range streams {
+				if method == "SUBSCRIBE" {
+					connection.streams[stream] = true
+				} else {
+					delete(connection.streams, stream)
+				}
+			}
+			connection.mu.Unlock()
+
+			if write(fmt.Sprintf(`{"id":"%s","result":null}`, json.Get(msg, "id").ToString())) != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			return
+		case message := <-connection.messages:
+			time.Sleep(time.Until(message.sentAt.Add(latency)))
+			if write(message.msg) != nil {
+				return
+			}
+		}
+	}
+}
+
+// Sends the trade "tradeId" of every stream to the connections subscribed to it
+func (server *testStreamServer) trade(tradeId int64, streams ...string) {
+	now := time.Now()
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	for connection := range server.connections {
+		connection.mu.Lock()
+		for _, stream := range streams {
+			if connection.streams[stream] {
+				connection.messages <- testBroadcast{
+					msg:    fmt.Sprintf(`{"stream":"%s","data":{"e":"aggTrade","E":%d,"a":%d}}`, stream, now.UnixMilli(), tradeId),
+					sentAt: now,
+				}
+			}
+		}
+		connection.mu.Unlock()
+	}
+}
+
+func TestStreamManagerDispatchesMovedStreamsOnceInOrder(t *testing.T) {
+	setWebsocketConstants(t, func(constants *WebsocketConstants) {
+		constants.MAX_OUTGOING_MESSAGES_PER_SECOND = 1000
+	})
+
+	server, url := newTestStreamServer(t)
+
+	var sockets []*Websocket
+	var socketsMu sync.Mutex
+	manager := newStreamManager(url, func(streams []string) (*Websocket, *Error) {
+		socket, err := createFuturesSocket(url, streams, true)
+		if err != nil {
+			return nil, err
+		}
+		socketsMu.Lock()
+		sockets = append(sockets, socket.Websocket)
+		socketsMu.Unlock()
+		return socket.Websocket, nil
+	}, []StreamManager_Params{{MaxStreamsPerSocket: 2}})
+	t.Cleanup(func() {
+		manager.Close()
+		socketsMu.Lock()
+		defer socketsMu.Unlock()
+		for _, socket := range sockets {
+			socket.routines.Wait()
+		}
+	})
+
+	var inListener atomic.Int32
+	var mu sync.Mutex
+	last := make(map[string]int64)
+	var errs []string
+	manager.SetMessageListener(func(stream string, msg []byte) {
+		if inListener.Add(1) != 1 {
+			t.Error("the listener was called concurrently")
+		}
+		defer inListener.Add(-1)
+
+		tradeId := json.Get(msg, "a").ToInt64()
+
+		mu.Lock()
+		defer mu.Unlock()
+		if tradeId <= last[stream] {
+			errs = append(errs, fmt.Sprintf("%s: trade %d received after %d", stream, tradeId, last[stream]))
+		}
+		last[stream] = tradeId
+	})
+
+	err := manager.Subscribe("a@aggTrade", "b@aggTrade", "c@aggTrade")
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+	if manager.Connections() != 2 {
+		t.Fatalf("expected 2 connections, got %d", manager.Connections())
+	}
+
+	stop := make(chan struct{})
+	var trading sync.WaitGroup
+	trading.Add(1)
+	go func() {
+		defer trading.Done()
+		for tradeId := int64(1); ; tradeId++ {
+			select {
+			case <-stop:
+				return
+			case <-time.After(time.Millisecond):
+				server.trade(tradeId, "a@aggTrade", "b@aggTrade", "c@aggTrade")
+			}
+		}
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	// "a@aggTrade" and "c@aggTrade" are left alone on their connections, one of them is moved into the other's
+	err = manager.Unsubscribe("b@aggTrade")
+	if err != nil {
+		t.Fatal(err.Message)
+	}
+	if manager.Connections() != 1 {
+		t.Fatalf("expected the streams to be moved into a single connection, got %d", manager.Connections())
+	}
+
+	mu.Lock()
+	beforeMove := last["a@aggTrade"]
+	mu.Unlock()
+
+	time.Sleep(200 * time.Millisecond)
+	close(stop)
+	trading.Wait()
+	time.Sleep(50 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, err := range errs {
+		t.Error(err)
+	}
+	if last["a@aggTrade"] <= beforeMove || last["c@aggTrade"] <= beforeMove {
+		t.Errorf("expected both streams to keep being received, got %v (%d before the move)", last, beforeMove)
+	}
+}
+
+func TestStreamManagerMoveDropsDuplicates(t *testing.T) {
+	manager := newStreamManager("wss://test", nil, nil)
+	t.Cleanup(manager.Close)
+
+	var mu sync.Mutex
+	var received []string
+	manager.SetMessageListener(func(stream string, msg []byte) {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, fmt.Sprintf("%s:%d", stream, json.Get(msg, "a").ToInt64()))
+	})
+
+	source, target := &streamShard{}, &streamShard{}
+	move := &streamMove{
+		target:   target,
+		rotation: &websocketRotation{streams: make(map[string]*rotationStream)},
+	}
+	manager.mu.Lock()
+	manager.moving["a@aggTrade"] = move
+	manager.mu.Unlock()
+
+	trade := func(shard *streamShard, stream string, tradeId int64) {
+		manager.post(streamManagerFrame{
+			shard:  shard,
+			stream: stream,
+			msg:    []byte(fmt.Sprintf(`{"e":"aggTrade","a":%d}`, tradeId)),
+		}, nil)
+	}
+
+	// The target subscribed from trade 3 on, it's held until the source went past it
+	trade(source, "a@aggTrade", 1)
+	trade(target, "a@aggTrade", 3)
+	trade(source, "a@aggTrade", 2)
+	trade(target, "a@aggTrade", 4)
+	trade(source, "a@aggTrade", 3)
+	trade(source, "a@aggTrade", 4)
+	trade(target, "a@aggTrade", 5)
+	// Still read from the source until it's closed
+	trade(source, "a@aggTrade", 5)
+	trade(target, "b@aggTrade", 1)
+
+	manager.post(streamManagerFrame{moved: move}, nil)
+	trade(target, "a@aggTrade", 6)
+	// Only received once the previous frames were dispatched
+	trade(target, "c@aggTrade", 1)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	expected := []string{"a@aggTrade:1", "a@aggTrade:2", "a@aggTrade:3", "a@aggTrade:4", "a@aggTrade:5", "b@aggTrade:1", "a@aggTrade:6"}
+	if strings.Join(received, " ") != strings.Join(expected, " ") {
+		t.Fatalf("expected %v, got %v", expected, received)
+	}
+
+	manager.mu.RLock()
+	defer manager.mu.RUnlock()
+	if len(manager.moving) != 0 {
+		t.Fatalf("expected the move to be done, %d streams are still filtered", len(manager.moving))
+	}
+}
@@ -0,0 +1,787 @@
+package Binance
+
+import (
+	"fmt"
+	"strings"
+)
+
+// # Sub-accounts
+//
+// Every call is made by the master account, sub-accounts are identified by their email.
+//
+// Use 'View(email)' to get a per sub-account view that fills in the email on its own.
+type SubAccounts struct {
+	binance       *Binance
+	requestClient RequestClient
+	baseURL       string
+
+	API APIKEYS
+}
+
+func (subAccounts *SubAccounts) init(binance *Binance) {
+	subAccounts.binance = binance
+
+	subAccounts.requestClient.init(binance)
+	subAccounts.requestClient.Set_APIKEY(binance.API.KEY, binance.API.SECRET)
+	subAccounts.baseURL = SUBACCOUNTS_Constants.URLs[0]
+
+	subAccounts.API.Set(binance.API.KEY, binance.API.SECRET)
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Create a Virtual Sub-account
+//
+// "subAccountString" is a string used to build the sub-account's virtual email
+//
+// Weight(IP): 1
+func (subAccounts *SubAccounts) Create(subAccountString string, recvWindow ...int64) (*SubAccounts_Email, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["subAccountString"] = subAccountString
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/sub-account/virtualSubAccount",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var email *SubAccounts_Email
+	processingErr := json.Unmarshal(resp.Body, &email)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return email, resp, nil
+}
+
+type SubAccounts_List_Params struct {
+	Email    string
+	IsFreeze *bool
+	// Default 1
+	Page int64
+	// Default 1, max 200
+	Limit      int64
+	RecvWindow int64
+}
+
+// # Query Sub-account List
+//
+// Weight(IP): 1
+func (subAccounts *SubAccounts) List(opt_params ...SubAccounts_List_Params) (*SubAccounts_List, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Email) {
+			opts["email"] = params.Email
+		}
+		if params.IsFreeze != nil {
+			opts["isFreeze"] = *params.IsFreeze
+		}
+		if IsDifferentFromDefault(params.Page) {
+			opts["page"] = params.Page
+		}
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/sub-account/list",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var list *SubAccounts_List
+	processingErr := json.Unmarshal(resp.Body, &list)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return list, resp, nil
+}
+
+// # Get Sub-account's Status on Margin/Futures
+//
+// If the email is not sent, the status of all sub-accounts will be returned.
+//
+// Weight(IP): 10
+func (subAccounts *SubAccounts) Status(email ...string) ([]*SubAccounts_Status, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(email) != 0 {
+		opts["email"] = email[0]
+	}
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/sub-account/status",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var statuses []*SubAccounts_Status
+	processingErr := json.Unmarshal(resp.Body, &statuses)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return statuses, resp, nil
+}
+
+// # Enable Futures for Sub-account
+//
+// Weight(IP): 1
+func (subAccounts *SubAccounts) EnableFutures(email string) (*SubAccounts_EnableFutures, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["email"] = email
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/sub-account/futures/enable",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var enabled *SubAccounts_EnableFutures
+	processingErr := json.Unmarshal(resp.Body, &enabled)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return enabled, resp, nil
+}
+
+// # Enable Margin for Sub-account
+//
+// Weight(IP): 1
+func (subAccounts *SubAccounts) EnableMargin(email string) (*SubAccounts_EnableMargin, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["email"] = email
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/sub-account/margin/enable",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var enabled *SubAccounts_EnableMargin
+	processingErr := json.Unmarshal(resp.Body, &enabled)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return enabled, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Query Sub-account Assets
+//
+// Weight(UID): 60
+func (subAccounts *SubAccounts) Assets(email string) (*SubAccounts_Assets, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["email"] = email
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v3/sub-account/assets",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var assets *SubAccounts_Assets
+	processingErr := json.Unmarshal(resp.Body, &assets)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return assets, resp, nil
+}
+
+type SubAccounts_SpotSummary_Params struct {
+	// Only the given sub-account is returned if sent
+	Email string
+	// Default 1
+	Page int64
+	// Default 10, max 20
+	Size       int64
+	RecvWindow int64
+}
+
+// # Query Sub-account Spot Assets Summary
+//
+// Weight(IP): 1
+func (subAccounts *SubAccounts) SpotSummary(opt_params ...SubAccounts_SpotSummary_Params) (*SubAccounts_SpotSummary, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Email) {
+			opts["email"] = params.Email
+		}
+		if IsDifferentFromDefault(params.Page) {
+			opts["page"] = params.Page
+		}
+		if IsDifferentFromDefault(params.Size) {
+			opts["size"] = params.Size
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/sub-account/spotSummary",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var summary *SubAccounts_SpotSummary
+	processingErr := json.Unmarshal(resp.Body, &summary)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return summary, resp, nil
+}
+
+// # Get Detail on Sub-account's Futures Account
+//
+// "futuresType" is one of SUBACCOUNTS_Constants.FuturesTypes
+//
+// Weight(IP): 1
+func (subAccounts *SubAccounts) FuturesAccount(email string, futuresType int64) (*SubAccounts_FuturesAccount, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["email"] = email
+	opts["futuresType"] = futuresType
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v2/sub-account/futures/account",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var account *SubAccounts_FuturesAccount
+	processingErr := json.Unmarshal(resp.Body, &account)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return account, resp, nil
+}
+
+type SubAccounts_FuturesSummary_Params struct {
+	// Default 1
+	Page int64
+	// Default 10, max 20
+	Limit      int64
+	RecvWindow int64
+}
+
+// # Get Summary of Sub-account's Futures Account
+//
+// "futuresType" is one of SUBACCOUNTS_Constants.FuturesTypes
+//
+// Weight(IP): 10
+func (subAccounts *SubAccounts) FuturesSummary(futuresType int64, opt_params ...SubAccounts_FuturesSummary_Params) (*SubAccounts_FuturesSummary, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["futuresType"] = futuresType
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Page) {
+			opts["page"] = params.Page
+		}
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v2/sub-account/futures/accountSummary",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var summary *SubAccounts_FuturesSummary
+	processingErr := json.Unmarshal(resp.Body, &summary)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return summary, resp, nil
+}
+
+// # Get Detail on Sub-account's Margin Account
+//
+// Weight(IP): 10
+func (subAccounts *SubAccounts) MarginAccount(email string) (*SubAccounts_MarginAccount, *Response, *Error) {
+	opts := make(map[string]interface{})
+	opts["email"] = email
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/sub-account/margin/account",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var account *SubAccounts_MarginAccount
+	processingErr := json.Unmarshal(resp.Body, &account)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return account, resp, nil
+}
+
+// # Get Summary of Sub-account's Margin Account
+//
+// Weight(IP): 10
+func (subAccounts *SubAccounts) MarginSummary(recvWindow ...int64) (*SubAccounts_MarginSummary, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/sub-account/margin/accountSummary",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var summary *SubAccounts_MarginSummary
+	processingErr := json.Unmarshal(resp.Body, &summary)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return summary, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+type SubAccounts_Transfer_Params struct {
+	// The master account is used if omitted
+	FromEmail string
+	// The master account is used if omitted
+	ToEmail string
+	// One of SUBACCOUNTS_Constants.AccountTypes
+	FromAccountType string
+	// One of SUBACCOUNTS_Constants.AccountTypes
+	ToAccountType string
+	// Must be unique
+	ClientTranId string
+	// Only for "ISOLATED_MARGIN"
+	Symbol     string
+	RecvWindow int64
+}
+
+// # Universal Transfer
+//
+// Transfers between the master account and its sub-accounts (master↔sub and sub↔sub), across their account types.
+//
+// At least one of "FromEmail" and "ToEmail" must be sent.
+//
+// Weight(IP): 360
+func (subAccounts *SubAccounts) Transfer(asset string, amount string, params SubAccounts_Transfer_Params) (*SubAccounts_Transfer, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["asset"] = asset
+	opts["amount"] = amount
+	opts["fromAccountType"] = params.FromAccountType
+	opts["toAccountType"] = params.ToAccountType
+
+	if IsDifferentFromDefault(params.FromEmail) {
+		opts["fromEmail"] = params.FromEmail
+	}
+	if IsDifferentFromDefault(params.ToEmail) {
+		opts["toEmail"] = params.ToEmail
+	}
+	if IsDifferentFromDefault(params.ClientTranId) {
+		opts["clientTranId"] = params.ClientTranId
+	}
+	if IsDifferentFromDefault(params.Symbol) {
+		opts["symbol"] = params.Symbol
+	}
+	if IsDifferentFromDefault(params.RecvWindow) {
+		opts["recvWindow"] = params.RecvWindow
+	}
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/sub-account/universalTransfer",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var transfer *SubAccounts_Transfer
+	processingErr := json.Unmarshal(resp.Body, &transfer)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return transfer, resp, nil
+}
+
+type SubAccounts_TransferHistory_Params struct {
+	FromEmail    string
+	ToEmail      string
+	ClientTranId string
+	StartTime    int64
+	EndTime      int64
+	// Default 1
+	Page int64
+	// Default 500, max 500
+	Limit      int64
+	RecvWindow int64
+}
+
+// # Query Universal Transfer History
+//
+// "FromEmail" and "ToEmail" cannot be sent at the same time, the master account's transfers are returned if neither is sent.
+//
+// Weight(IP): 1
+func (subAccounts *SubAccounts) TransferHistory(opt_params ...SubAccounts_TransferHistory_Params) (*SubAccounts_TransferHistory, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.FromEmail) {
+			opts["fromEmail"] = params.FromEmail
+		}
+		if IsDifferentFromDefault(params.ToEmail) {
+			opts["toEmail"] = params.ToEmail
+		}
+		if IsDifferentFromDefault(params.ClientTranId) {
+			opts["clientTranId"] = params.ClientTranId
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.Page) {
+			opts["page"] = params.Page
+		}
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/sub-account/universalTransfer",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var history *SubAccounts_TransferHistory
+	processingErr := json.Unmarshal(resp.Body, &history)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return history, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Get IP Restriction for a Sub-account API Key
+//
+// Weight(UID): 3000
+func (subAccounts *SubAccounts) IPRestriction(email string, subAccountApiKey string) (*SubAccounts_IPRestriction, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["email"] = email
+	opts["subAccountApiKey"] = subAccountApiKey
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/sub-account/subAccountApi/ipRestriction",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var restriction *SubAccounts_IPRestriction
+	processingErr := json.Unmarshal(resp.Body, &restriction)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return restriction, resp, nil
+}
+
+// # Add IP Restriction for a Sub-account API Key
+//
+// Restricts the sub-account's API key to the given IPs, the key is left unrestricted if no IP is sent.
+//
+// Weight(UID): 3000
+func (subAccounts *SubAccounts) SetIPRestriction(email string, subAccountApiKey string, ipAddresses ...string) (*SubAccounts_SetIPRestriction, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["email"] = email
+	opts["subAccountApiKey"] = subAccountApiKey
+	if len(ipAddresses) != 0 {
+		opts["status"] = "2"
+		opts["ipAddress"] = strings.Join(ipAddresses, ",")
+	} else {
+		opts["status"] = "1"
+	}
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v2/sub-account/subAccountApi/ipRestriction",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var restriction *SubAccounts_SetIPRestriction
+	processingErr := json.Unmarshal(resp.Body, &restriction)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return restriction, resp, nil
+}
+
+// # Delete IP List For a Sub-account API Key
+//
+// Weight(UID): 3000
+func (subAccounts *SubAccounts) DeleteIPRestriction(email string, subAccountApiKey string, ipAddresses ...string) (*SubAccounts_IPRestriction, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["email"] = email
+	opts["subAccountApiKey"] = subAccountApiKey
+	opts["ipAddress"] = strings.Join(ipAddresses, ",")
+
+	resp, err := subAccounts.makeRequest(&SubAccountsRequest{
+		securityType: SUBACCOUNTS_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.DELETE,
+		url:          "/sapi/v1/sub-account/subAccountApi/ipRestriction/ipList",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var restriction *SubAccounts_IPRestriction
+	processingErr := json.Unmarshal(resp.Body, &restriction)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return restriction, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Sub-account view
+//
+// Derived from the master client with 'binance.SubAccounts.View(email)', every call is routed through the master account on behalf of the sub-account.
+type SubAccount struct {
+	Email string
+
+	subAccounts *SubAccounts
+}
+
+func (subAccounts *SubAccounts) View(email string) *SubAccount {
+	return &SubAccount{
+		Email:       email,
+		subAccounts: subAccounts,
+	}
+}
+
+func (subAccount *SubAccount) Status() (*SubAccounts_Status, *Response, *Error) {
+	statuses, resp, err := subAccount.subAccounts.Status(subAccount.Email)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, status := range statuses {
+		if status.Email == subAccount.Email {
+			return status, resp, nil
+		}
+	}
+
+	return nil, resp, LocalError(DATA_NOT_FOUND_ERR, fmt.Sprintf("No status was returned for sub-account '%s'", subAccount.Email))
+}
+
+func (subAccount *SubAccount) EnableFutures() (*SubAccounts_EnableFutures, *Response, *Error) {
+	return subAccount.subAccounts.EnableFutures(subAccount.Email)
+}
+
+func (subAccount *SubAccount) EnableMargin() (*SubAccounts_EnableMargin, *Response, *Error) {
+	return subAccount.subAccounts.EnableMargin(subAccount.Email)
+}
+
+func (subAccount *SubAccount) Assets() (*SubAccounts_Assets, *Response, *Error) {
+	return subAccount.subAccounts.Assets(subAccount.Email)
+}
+
+func (subAccount *SubAccount) SpotSummary() (*SubAccounts_SpotSummary, *Response, *Error) {
+	return subAccount.subAccounts.SpotSummary(SubAccounts_SpotSummary_Params{Email: subAccount.Email})
+}
+
+// "futuresType" is one of SUBACCOUNTS_Constants.FuturesTypes
+func (subAccount *SubAccount) FuturesAccount(futuresType int64) (*SubAccounts_FuturesAccount, *Response, *Error) {
+	return subAccount.subAccounts.FuturesAccount(subAccount.Email, futuresType)
+}
+
+func (subAccount *SubAccount) MarginAccount() (*SubAccounts_MarginAccount, *Response, *Error) {
+	return subAccount.subAccounts.MarginAccount(subAccount.Email)
+}
+
+type SubAccount_Transfer_Params struct {
+	ClientTranId string
+	// Only for "ISOLATED_MARGIN"
+	Symbol     string
+	RecvWindow int64
+}
+
+func (subAccount *SubAccount) transfer(asset string, amount string, params SubAccounts_Transfer_Params, opt_params []SubAccount_Transfer_Params) (*SubAccounts_Transfer, *Response, *Error) {
+	if len(opt_params) != 0 {
+		params.ClientTranId = opt_params[0].ClientTranId
+		params.Symbol = opt_params[0].Symbol
+		params.RecvWindow = opt_params[0].RecvWindow
+	}
+
+	return subAccount.subAccounts.Transfer(asset, amount, params)
+}
+
+// Transfers from the master account's "fromAccountType" to this sub-account's "toAccountType"
+func (subAccount *SubAccount) TransferFromMaster(asset string, amount string, fromAccountType string, toAccountType string, opt_params ...SubAccount_Transfer_Params) (*SubAccounts_Transfer, *Response, *Error) {
+	return subAccount.transfer(asset, amount, SubAccounts_Transfer_Params{
+		ToEmail:         subAccount.Email,
+		FromAccountType: fromAccountType,
+		ToAccountType:   toAccountType,
+	}, opt_params)
+}
+
+// Transfers from this sub-account's "fromAccountType" to the master account's "toAccountType"
+func (subAccount *SubAccount) TransferToMaster(asset string, amount string, fromAccountType string, toAccountType string, opt_params ...SubAccount_Transfer_Params) (*SubAccounts_Transfer, *Response, *Error) {
+	return subAccount.transfer(asset, amount, SubAccounts_Transfer_Params{
+		FromEmail:       subAccount.Email,
+		FromAccountType: fromAccountType,
+		ToAccountType:   toAccountType,
+	}, opt_params)
+}
+
+// Transfers from this sub-account's "fromAccountType" to another sub-account's "toAccountType"
+func (subAccount *SubAccount) TransferToSubAccount(toEmail string, asset string, amount string, fromAccountType string, toAccountType string, opt_params ...SubAccount_Transfer_Params) (*SubAccounts_Transfer, *Response, *Error) {
+	return subAccount.transfer(asset, amount, SubAccounts_Transfer_Params{
+		FromEmail:       subAccount.Email,
+		ToEmail:         toEmail,
+		FromAccountType: fromAccountType,
+		ToAccountType:   toAccountType,
+	}, opt_params)
+}
+
+// Transfers out of this sub-account
+func (subAccount *SubAccount) TransferHistory(opt_params ...SubAccounts_TransferHistory_Params) (*SubAccounts_TransferHistory, *Response, *Error) {
+	var params SubAccounts_TransferHistory_Params
+	if len(opt_params) != 0 {
+		params = opt_params[0]
+	}
+	params.FromEmail = subAccount.Email
+	params.ToEmail = ""
+
+	return subAccount.subAccounts.TransferHistory(params)
+}
+
+func (subAccount *SubAccount) IPRestriction(subAccountApiKey string) (*SubAccounts_IPRestriction, *Response, *Error) {
+	return subAccount.subAccounts.IPRestriction(subAccount.Email, subAccountApiKey)
+}
+
+func (subAccount *SubAccount) SetIPRestriction(subAccountApiKey string, ipAddresses ...string) (*SubAccounts_SetIPRestriction, *Response, *Error) {
+	return subAccount.subAccounts.SetIPRestriction(subAccount.Email, subAccountApiKey, ipAddresses...)
+}
+
+func (subAccount *SubAccount) DeleteIPRestriction(subAccountApiKey string, ipAddresses ...string) (*SubAccounts_IPRestriction, *Response, *Error) {
+	return subAccount.subAccounts.DeleteIPRestriction(subAccount.Email, subAccountApiKey, ipAddresses...)
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+type SubAccountsRequest struct {
+	method       string
+	url          string
+	params       map[string]interface{}
+	securityType string
+}
+
+func (subAccounts *SubAccounts) makeRequest(request *SubAccountsRequest) (*Response, *Error) {
+
+	switch request.securityType {
+	case SUBACCOUNTS_Constants.SecurityTypes.USER_DATA:
+		return subAccounts.requestClient.Signed(request.method, subAccounts.baseURL, request.url, request.params)
+
+	default:
+		panic(fmt.Sprintf("Security Type passed to Request function is invalid, received: '%s'\nSupported methods are ('%s')", request.securityType, SUBACCOUNTS_Constants.SecurityTypes.USER_DATA))
+	}
+
+}
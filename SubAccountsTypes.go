@@ -0,0 +1,268 @@
+package Binance
+
+var SUBACCOUNTS_Constants = struct {
+	URLs             [1]string
+	SecurityTypes    SubAccounts_SecurityTypes_ENUM
+	AccountTypes     SubAccounts_AccountTypes_ENUM
+	FuturesTypes     SubAccounts_FuturesTypes_ENUM
+	TransferStatuses SubAccounts_TransferStatuses_ENUM
+}{
+	URLs: [1]string{"https://api.binance.com"},
+	SecurityTypes: SubAccounts_SecurityTypes_ENUM{
+		USER_DATA: "USER_DATA",
+	},
+	AccountTypes: SubAccounts_AccountTypes_ENUM{
+		SPOT:            "SPOT",
+		USDT_FUTURE:     "USDT_FUTURE",
+		COIN_FUTURE:     "COIN_FUTURE",
+		MARGIN:          "MARGIN",
+		ISOLATED_MARGIN: "ISOLATED_MARGIN",
+	},
+	FuturesTypes: SubAccounts_FuturesTypes_ENUM{
+		USDM:  1,
+		COINM: 2,
+	},
+	TransferStatuses: SubAccounts_TransferStatuses_ENUM{
+		PROCESS: "PROCESS",
+		SUCCESS: "SUCCESS",
+		FAILURE: "FAILURE",
+	},
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////// Declarations
+//////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////// Definitions
+
+type SubAccounts_SecurityTypes_ENUM struct {
+	USER_DATA string
+}
+
+type SubAccounts_AccountTypes_ENUM struct {
+	SPOT            string
+	USDT_FUTURE     string
+	COIN_FUTURE     string
+	MARGIN          string
+	ISOLATED_MARGIN string
+}
+
+type SubAccounts_FuturesTypes_ENUM struct {
+	USDM  int64
+	COINM int64
+}
+
+type SubAccounts_TransferStatuses_ENUM struct {
+	PROCESS string
+	SUCCESS string
+	FAILURE string
+}
+
+//////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type SubAccounts_Email struct {
+	Email string `json:"email"`
+}
+
+type SubAccounts_List struct {
+	SubAccounts []*SubAccounts_SubAccount `json:"subAccounts"`
+}
+
+type SubAccounts_SubAccount struct {
+	Email                       string `json:"email"`
+	IsFreeze                    bool   `json:"isFreeze"`
+	CreateTime                  int64  `json:"createTime"`
+	IsManagedSubAccount         bool   `json:"isManagedSubAccount"`
+	IsAssetManagementSubAccount bool   `json:"isAssetManagementSubAccount"`
+}
+
+type SubAccounts_Status struct {
+	Email            string `json:"email"`
+	IsSubUserEnabled bool   `json:"isSubUserEnabled"`
+	IsUserActive     bool   `json:"isUserActive"`
+	InsertTime       int64  `json:"insertTime"`
+	IsMarginEnabled  bool   `json:"isMarginEnabled"`
+	IsFutureEnabled  bool   `json:"isFutureEnabled"`
+	Mobile           int64  `json:"mobile"`
+}
+
+type SubAccounts_Assets struct {
+	Balances []*SubAccounts_Assets_Balance `json:"balances"`
+}
+
+type SubAccounts_Assets_Balance struct {
+	Asset  string `json:"asset"`
+	Free   string `json:"free"`
+	Locked string `json:"locked"`
+}
+
+type SubAccounts_SpotSummary struct {
+	TotalCount                int64                                 `json:"totalCount"`
+	MasterAccountTotalAsset   string                                `json:"masterAccountTotalAsset"`
+	SpotSubUserAssetBtcVoList []*SubAccounts_SpotSummary_SubAccount `json:"spotSubUserAssetBtcVoList"`
+}
+
+type SubAccounts_SpotSummary_SubAccount struct {
+	Email string `json:"email"`
+	// In BTC
+	TotalAsset string `json:"totalAsset"`
+}
+
+// Only one of "FutureAccountResp" and "DeliveryAccountResp" is set, depending on the futuresType queried
+type SubAccounts_FuturesAccount struct {
+	FutureAccountResp   *SubAccounts_FuturesAccount_Details `json:"futureAccountResp"`
+	DeliveryAccountResp *SubAccounts_FuturesAccount_Details `json:"deliveryAccountResp"`
+}
+
+type SubAccounts_FuturesAccount_Details struct {
+	Email       string                              `json:"email"`
+	Assets      []*SubAccounts_FuturesAccount_Asset `json:"assets"`
+	CanDeposit  bool                                `json:"canDeposit"`
+	CanTrade    bool                                `json:"canTrade"`
+	CanWithdraw bool                                `json:"canWithdraw"`
+	FeeTier     int64                               `json:"feeTier"`
+	UpdateTime  int64                               `json:"updateTime"`
+
+	// USDⓈ-M only
+	MaxWithdrawAmount           string `json:"maxWithdrawAmount"`
+	TotalInitialMargin          string `json:"totalInitialMargin"`
+	TotalMaintenanceMargin      string `json:"totalMaintenanceMargin"`
+	TotalMarginBalance          string `json:"totalMarginBalance"`
+	TotalOpenOrderInitialMargin string `json:"totalOpenOrderInitialMargin"`
+	TotalPositionInitialMargin  string `json:"totalPositionInitialMargin"`
+	TotalUnrealizedProfit       string `json:"totalUnrealizedProfit"`
+	TotalWalletBalance          string `json:"totalWalletBalance"`
+	Asset                       string `json:"asset"`
+}
+
+type SubAccounts_FuturesAccount_Asset struct {
+	Asset                  string `json:"asset"`
+	InitialMargin          string `json:"initialMargin"`
+	MaintenanceMargin      string `json:"maintenanceMargin"`
+	MarginBalance          string `json:"marginBalance"`
+	MaxWithdrawAmount      string `json:"maxWithdrawAmount"`
+	OpenOrderInitialMargin string `json:"openOrderInitialMargin"`
+	PositionInitialMargin  string `json:"positionInitialMargin"`
+	UnrealizedProfit       string `json:"unrealizedProfit"`
+	WalletBalance          string `json:"walletBalance"`
+}
+
+// Only one of "FutureAccountSummaryResp" and "DeliveryAccountSummaryResp" is set, depending on the futuresType queried
+type SubAccounts_FuturesSummary struct {
+	FutureAccountSummaryResp   *SubAccounts_FuturesSummary_Details `json:"futureAccountSummaryResp"`
+	DeliveryAccountSummaryResp *SubAccounts_FuturesSummary_Details `json:"deliveryAccountSummaryResp"`
+}
+
+type SubAccounts_FuturesSummary_Details struct {
+	// USDⓈ-M only
+	TotalInitialMargin          string `json:"totalInitialMargin"`
+	TotalMaintenanceMargin      string `json:"totalMaintenanceMargin"`
+	TotalMarginBalance          string `json:"totalMarginBalance"`
+	TotalOpenOrderInitialMargin string `json:"totalOpenOrderInitialMargin"`
+	TotalPositionInitialMargin  string `json:"totalPositionInitialMargin"`
+	TotalUnrealizedProfit       string `json:"totalUnrealizedProfit"`
+	TotalWalletBalance          string `json:"totalWalletBalance"`
+	Asset                       string `json:"asset"`
+
+	// COIN-M only, in USD
+	TotalMarginBalanceOfBTC    string `json:"totalMarginBalanceOfBTC"`
+	TotalUnrealizedProfitOfBTC string `json:"totalUnrealizedProfitOfBTC"`
+	TotalWalletBalanceOfBTC    string `json:"totalWalletBalanceOfBTC"`
+
+	SubAccountList []*SubAccounts_FuturesSummary_SubAccount `json:"subAccountList"`
+}
+
+type SubAccounts_FuturesSummary_SubAccount struct {
+	Email                       string `json:"email"`
+	TotalInitialMargin          string `json:"totalInitialMargin"`
+	TotalMaintenanceMargin      string `json:"totalMaintenanceMargin"`
+	TotalMarginBalance          string `json:"totalMarginBalance"`
+	TotalOpenOrderInitialMargin string `json:"totalOpenOrderInitialMargin"`
+	TotalPositionInitialMargin  string `json:"totalPositionInitialMargin"`
+	TotalUnrealizedProfit       string `json:"totalUnrealizedProfit"`
+	TotalWalletBalance          string `json:"totalWalletBalance"`
+	Asset                       string `json:"asset"`
+}
+
+type SubAccounts_MarginAccount struct {
+	Email                 string                           `json:"email"`
+	MarginLevel           string                           `json:"marginLevel"`
+	TotalAssetOfBtc       string                           `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc   string                           `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc    string                           `json:"totalNetAssetOfBtc"`
+	MarginTradeCoeffVo    *SubAccounts_MarginAccount_Coeff `json:"marginTradeCoeffVo"`
+	MarginUserAssetVoList []*Margin_CrossAccount_Asset     `json:"marginUserAssetVoList"`
+}
+
+type SubAccounts_MarginAccount_Coeff struct {
+	// Liquidation margin ratio
+	ForceLiquidationBar string `json:"forceLiquidationBar"`
+	// Margin call margin ratio
+	MarginCallBar string `json:"marginCallBar"`
+	// Initial margin ratio
+	NormalBar string `json:"normalBar"`
+}
+
+type SubAccounts_MarginSummary struct {
+	TotalAssetOfBtc     string                                  `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc string                                  `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc  string                                  `json:"totalNetAssetOfBtc"`
+	SubAccountList      []*SubAccounts_MarginSummary_SubAccount `json:"subAccountList"`
+}
+
+type SubAccounts_MarginSummary_SubAccount struct {
+	Email               string `json:"email"`
+	TotalAssetOfBtc     string `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc string `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc  string `json:"totalNetAssetOfBtc"`
+}
+
+type SubAccounts_EnableFutures struct {
+	Email            string `json:"email"`
+	IsFuturesEnabled bool   `json:"isFuturesEnabled"`
+}
+
+type SubAccounts_EnableMargin struct {
+	Email           string `json:"email"`
+	IsMarginEnabled bool   `json:"isMarginEnabled"`
+}
+
+type SubAccounts_Transfer struct {
+	TranId       int64  `json:"tranId"`
+	ClientTranId string `json:"clientTranId"`
+}
+
+type SubAccounts_TransferHistory struct {
+	Result     []*SubAccounts_TransferRecord `json:"result"`
+	TotalCount int64                         `json:"totalCount"`
+}
+
+type SubAccounts_TransferRecord struct {
+	TranId int64 `json:"tranId"`
+	// Empty for the master account
+	FromEmail string `json:"fromEmail"`
+	// Empty for the master account
+	ToEmail         string `json:"toEmail"`
+	Asset           string `json:"asset"`
+	Amount          string `json:"amount"`
+	CreateTimeStamp int64  `json:"createTimeStamp"`
+	FromAccountType string `json:"fromAccountType"`
+	ToAccountType   string `json:"toAccountType"`
+	// One of SUBACCOUNTS_Constants.TransferStatuses
+	Status       string `json:"status"`
+	ClientTranId string `json:"clientTranId"`
+}
+
+type SubAccounts_IPRestriction struct {
+	// "true" if the API key is IP restricted
+	IpRestrict string   `json:"ipRestrict"`
+	IpList     []string `json:"ipList"`
+	UpdateTime int64    `json:"updateTime"`
+	ApiKey     string   `json:"apiKey"`
+}
+
+type SubAccounts_SetIPRestriction struct {
+	// "1": IP unrestricted, "2": IP restricted
+	Status     string   `json:"status"`
+	IpList     []string `json:"ipList"`
+	UpdateTime int64    `json:"updateTime"`
+	ApiKey     string   `json:"apiKey"`
+}
@@ -3,6 +3,7 @@ package Binance
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -112,15 +113,9 @@ func ParseInt(intStr string) (int64, error) {
 	return strconv.ParseInt(intStr, 10, 64)
 }
 
+// strconv.ParseFloat already returns the float nearest to the string, rounding it again could only move it away
 func ParseFloat(floatStr string) (float64, error) {
-	precision := GetStringNumberPrecision(floatStr)
-
-	float, err := strconv.ParseFloat(floatStr, 64)
-	if err != nil {
-		return float, err
-	}
-
-	return ToFixed_Round(float, precision), nil
+	return strconv.ParseFloat(floatStr, 64)
 }
 
 func GetStringNumberPrecision(numStr string) int {
@@ -167,10 +162,19 @@ func DetectDotNumIndexes(numStr string) (dotIndex int, numIndex int) {
 	return dotIndex, numIndex
 }
 
+// # Floors "priceStr" to a multiple of "tickSize"
+//
+// Works for any step, i.e: "0.5" or "10", not only powers of ten
 func Format_TickSize_str(priceStr string, tickSize string) string {
 	precision := GetStringNumberPrecision(tickSize)
 
-	return Round_priceStr(priceStr, precision)
+	price, priceErr := ParseDecimal(priceStr)
+	step, stepErr := ParseDecimal(tickSize)
+	if priceErr != nil || stepErr != nil || step.Sign() <= 0 {
+		return Round_priceStr(priceStr, precision)
+	}
+
+	return price.RoundToStep(step, ROUND_FLOOR).StringFixed(int32(precision))
 }
 
 func Round_priceStr(priceStr string, precision int) string {
@@ -218,13 +222,54 @@ func Round_priceStr(priceStr string, precision int) string {
 }
 
 func ToFixed_Floor(price float64, precision int) float64 {
-	return math.Floor(price*math.Pow10(precision)) / math.Pow10(precision)
+	return toFixed(price, precision, ROUND_FLOOR, math.Floor)
 }
 
 func ToFixed_Round(price float64, precision int) float64 {
-	return math.Round(price*math.Pow10(precision)) / math.Pow10(precision)
+	return toFixed(price, precision, ROUND_HALF_UP, math.Round)
 }
 
 func ToFixed_Ceil(price float64, precision int) float64 {
-	return math.Ceil(price*math.Pow10(precision)) / math.Pow10(precision)
+	return toFixed(price, precision, ROUND_CEIL, math.Ceil)
+}
+
+// Rounds through Decimal, multiplying by math.Pow10 gives off-by-one-tick results on values such as 1.005
+func toFixed(price float64, precision int, mode RoundingMode, fallback func(float64) float64) float64 {
+	decimal, err := NewDecimalFromFloat(price)
+	if err != nil {
+		return fallback(price*math.Pow10(precision)) / math.Pow10(precision)
+	}
+	return decimal.Round(int32(precision), mode).Float64()
+}
+
+// Returns true if every value of "want" is in "have"
+func containsAll(have []string, want []string) bool {
+	for _, wanted := range want {
+		found := false
+		for _, value := range have {
+			if value == wanted {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func uniqueSorted(values []string) []string {
+	sort.Strings(values)
+
+	unique := values[:0]
+	for i, value := range values {
+		if i == 0 || value != values[i-1] {
+			unique = append(unique, value)
+		}
+	}
+
+	return unique
 }
@@ -0,0 +1,754 @@
+package Binance
+
+import (
+	"fmt"
+	"strings"
+)
+
+// # Wallet
+//
+// /sapi endpoints are limited either by IP or by UID, each endpoint states its own limit as "Weight(IP)" or "Weight(UID)".
+//
+// Read the used weights with Response.GetSAPIUsedIPWeight("1m") and Response.GetSAPIUsedUIDWeight("1m"), the limits are in WALLET_Constants.RateLimits.
+type Wallet struct {
+	binance       *Binance
+	requestClient RequestClient
+	baseURL       string
+
+	API APIKEYS
+}
+
+func (wallet *Wallet) init(binance *Binance) {
+	wallet.binance = binance
+
+	wallet.requestClient.init(binance)
+	wallet.requestClient.Set_APIKEY(binance.API.KEY, binance.API.SECRET)
+	wallet.baseURL = WALLET_Constants.URLs[0]
+
+	wallet.API.Set(binance.API.KEY, binance.API.SECRET)
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # System Status
+//
+// Weight(IP): 1
+func (wallet *Wallet) SystemStatus() (*Wallet_SystemStatus, *Response, *Error) {
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.NONE,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/system/status",
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var status *Wallet_SystemStatus
+	processingErr := json.Unmarshal(resp.Body, &status)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return status, resp, nil
+}
+
+// # All Coins' Information
+//
+// Get information of coins (available for deposit and withdraw) for user, including their networks.
+//
+// Weight(IP): 10
+func (wallet *Wallet) CoinInfo(recvWindow ...int64) ([]*Wallet_Coin, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/capital/config/getall",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var coins []*Wallet_Coin
+	processingErr := json.Unmarshal(resp.Body, &coins)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return coins, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+// //////////////////////////// Deposits \\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\
+
+type Wallet_DepositAddress_Params struct {
+	// The coin's default network is used if omitted
+	Network string
+	// Only used for some networks (i.e: Lightning), the amount of the deposit
+	Amount     string
+	RecvWindow int64
+}
+
+// # Deposit Address
+//
+// Weight(IP): 10
+func (wallet *Wallet) DepositAddress(coin string, opt_params ...Wallet_DepositAddress_Params) (*Wallet_DepositAddress, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["coin"] = coin
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Network) {
+			opts["network"] = params.Network
+		}
+		if IsDifferentFromDefault(params.Amount) {
+			opts["amount"] = params.Amount
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/capital/deposit/address",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var address *Wallet_DepositAddress
+	processingErr := json.Unmarshal(resp.Body, &address)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return address, resp, nil
+}
+
+type Wallet_DepositHistory_Params struct {
+	Coin string
+	// One of WALLET_Constants.DepositStatuses, pointer since 0 (pending) is a valid status
+	Status *int64
+	// Default: 90 days from current timestamp
+	StartTime int64
+	// Default: present timestamp
+	EndTime int64
+	// Default: 0
+	Offset int64
+	// Default: 1000, Max: 1000
+	Limit int64
+	TxId  string
+	// Include the source address of each deposit
+	IncludeSource bool
+	RecvWindow    int64
+}
+
+// # Deposit History
+//
+// Weight(IP): 1
+func (wallet *Wallet) DepositHistory(opt_params ...Wallet_DepositHistory_Params) ([]*Wallet_Deposit, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Coin) {
+			opts["coin"] = params.Coin
+		}
+		if params.Status != nil {
+			opts["status"] = *params.Status
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.Offset) {
+			opts["offset"] = params.Offset
+		}
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+		if IsDifferentFromDefault(params.TxId) {
+			opts["txId"] = params.TxId
+		}
+		if params.IncludeSource {
+			opts["includeSource"] = true
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/capital/deposit/hisrec",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var deposits []*Wallet_Deposit
+	processingErr := json.Unmarshal(resp.Body, &deposits)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return deposits, resp, nil
+}
+
+// \\\\\\\\\\\\\\\\\\\\\\\\\\\ Deposits ////////////////////////////////////////
+
+// //////////////////////////// Withdrawals \\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\
+
+type Wallet_Withdraw_Params struct {
+	// The coin's default network is used if omitted
+	Network string
+	// Client ID for the withdrawal
+	WithdrawOrderId string
+	// Secondary address identifier for coins like XRP, XMR etc.
+	AddressTag string
+	// When making internal transfer, true for returning the fee to the destination account; false for returning the fee back to the departure account. Default false.
+	TransactionFeeFlag bool
+	// Description of the address
+	Name string
+	// One of WALLET_Constants.WithdrawWalletTypes, the spot wallet is used by default
+	WalletType int64
+	RecvWindow int64
+}
+
+// # Withdraw
+//
+// Submit a withdrawal request.
+//
+// Weight(UID): 600
+func (wallet *Wallet) Withdraw(coin string, address string, amount string, opt_params ...Wallet_Withdraw_Params) (*Wallet_WithdrawId, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["coin"] = coin
+	opts["address"] = address
+	opts["amount"] = amount
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Network) {
+			opts["network"] = params.Network
+		}
+		if IsDifferentFromDefault(params.WithdrawOrderId) {
+			opts["withdrawOrderId"] = params.WithdrawOrderId
+		}
+		if IsDifferentFromDefault(params.AddressTag) {
+			opts["addressTag"] = params.AddressTag
+		}
+		if params.TransactionFeeFlag {
+			opts["transactionFeeFlag"] = true
+		}
+		if IsDifferentFromDefault(params.Name) {
+			opts["name"] = params.Name
+		}
+		if IsDifferentFromDefault(params.WalletType) {
+			opts["walletType"] = params.WalletType
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/capital/withdraw/apply",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var withdrawId *Wallet_WithdrawId
+	processingErr := json.Unmarshal(resp.Body, &withdrawId)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return withdrawId, resp, nil
+}
+
+type Wallet_WithdrawHistory_Params struct {
+	Coin            string
+	WithdrawOrderId string
+	// One of WALLET_Constants.WithdrawStatuses, pointer since 0 (email sent) is a valid status
+	Status *int64
+	Offset int64
+	// Default: 1000, Max: 1000
+	Limit int64
+	// Withdrawal IDs, max 45
+	IdList []string
+	// Default: 90 days from current timestamp
+	StartTime int64
+	// Default: present timestamp
+	EndTime    int64
+	RecvWindow int64
+}
+
+// # Withdraw History
+//
+// Weight(UID): 18000, which limits this endpoint to 10 requests per second
+func (wallet *Wallet) WithdrawHistory(opt_params ...Wallet_WithdrawHistory_Params) ([]*Wallet_Withdrawal, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Coin) {
+			opts["coin"] = params.Coin
+		}
+		if IsDifferentFromDefault(params.WithdrawOrderId) {
+			opts["withdrawOrderId"] = params.WithdrawOrderId
+		}
+		if params.Status != nil {
+			opts["status"] = *params.Status
+		}
+		if IsDifferentFromDefault(params.Offset) {
+			opts["offset"] = params.Offset
+		}
+		if IsDifferentFromDefault(params.Limit) {
+			opts["limit"] = params.Limit
+		}
+		if len(params.IdList) != 0 {
+			opts["idList"] = strings.Join(params.IdList, ",")
+		}
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/capital/withdraw/history",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var withdrawals []*Wallet_Withdrawal
+	processingErr := json.Unmarshal(resp.Body, &withdrawals)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return withdrawals, resp, nil
+}
+
+// \\\\\\\\\\\\\\\\\\\\\\\\\\\ Withdrawals ////////////////////////////////////////
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+type Wallet_UniversalTransfer_Params struct {
+	// Required when transferring out of an isolated margin account
+	FromSymbol string
+	// Required when transferring into an isolated margin account
+	ToSymbol   string
+	RecvWindow int64
+}
+
+// # User Universal Transfer
+//
+// Moves an asset between the spot, funding, margin, futures and options wallets.
+//
+// "transferType" is one of WALLET_Constants.TransferTypes, i.e: "MAIN_UMFUTURE" moves the asset from the spot wallet to the USDⓈ-M Futures wallet
+//
+// Weight(UID): 900
+func (wallet *Wallet) UniversalTransfer(transferType string, asset string, amount string, opt_params ...Wallet_UniversalTransfer_Params) (*Wallet_Transaction, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["type"] = transferType
+	opts["asset"] = asset
+	opts["amount"] = amount
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.FromSymbol) {
+			opts["fromSymbol"] = params.FromSymbol
+		}
+		if IsDifferentFromDefault(params.ToSymbol) {
+			opts["toSymbol"] = params.ToSymbol
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/asset/transfer",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var transaction *Wallet_Transaction
+	processingErr := json.Unmarshal(resp.Body, &transaction)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return transaction, resp, nil
+}
+
+type Wallet_UniversalTransferHistory_Params struct {
+	StartTime int64
+	EndTime   int64
+	// Default 1
+	Current int64
+	// Default 10, Max 100
+	Size       int64
+	FromSymbol string
+	ToSymbol   string
+	RecvWindow int64
+}
+
+// # Query User Universal Transfer History
+//
+// # Only the last 6 months of transfers can be queried
+//
+// Weight(IP): 1
+func (wallet *Wallet) UniversalTransferHistory(transferType string, opt_params ...Wallet_UniversalTransferHistory_Params) (*Wallet_TransferHistory, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	opts["type"] = transferType
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.Current) {
+			opts["current"] = params.Current
+		}
+		if IsDifferentFromDefault(params.Size) {
+			opts["size"] = params.Size
+		}
+		if IsDifferentFromDefault(params.FromSymbol) {
+			opts["fromSymbol"] = params.FromSymbol
+		}
+		if IsDifferentFromDefault(params.ToSymbol) {
+			opts["toSymbol"] = params.ToSymbol
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/asset/transfer",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var history *Wallet_TransferHistory
+	processingErr := json.Unmarshal(resp.Body, &history)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return history, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Asset Detail
+//
+// Fetch details of assets supported on Binance, mapped by asset.
+//
+// If the asset is not sent, the details of all assets will be returned.
+//
+// Weight(IP): 1
+func (wallet *Wallet) AssetDetail(asset ...string) (map[string]*Wallet_AssetDetail, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(asset) != 0 {
+		opts["asset"] = asset[0]
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/asset/assetDetail",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var details map[string]*Wallet_AssetDetail
+	processingErr := json.Unmarshal(resp.Body, &details)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return details, resp, nil
+}
+
+// # Trade Fee
+//
+// Fetch trade fee.
+//
+// If the symbol is not sent, the fees of all symbols will be returned.
+//
+// Weight(IP): 1
+func (wallet *Wallet) TradeFee(symbol ...string) ([]*Wallet_TradeFee, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(symbol) != 0 {
+		opts["symbol"] = symbol[0]
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/asset/tradeFee",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var fees []*Wallet_TradeFee
+	processingErr := json.Unmarshal(resp.Body, &fees)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return fees, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Get Assets That Can Be Converted Into BNB
+//
+// Weight(IP): 1
+func (wallet *Wallet) DustAssets(recvWindow ...int64) (*Wallet_DustAssets, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/asset/dust-btc",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var dustAssets *Wallet_DustAssets
+	processingErr := json.Unmarshal(resp.Body, &dustAssets)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return dustAssets, resp, nil
+}
+
+// # Dust Transfer
+//
+// Convert dust assets to BNB.
+//
+// Weight(UID): 10
+func (wallet *Wallet) DustTransfer(assets ...string) (*Wallet_DustTransfer, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	// Sent as repeated "asset" keys
+	assetList := make([]interface{}, len(assets))
+	for i := range assets {
+		assetList[i] = assets[i]
+	}
+	opts["asset"] = assetList
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/asset/dust",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var transfer *Wallet_DustTransfer
+	processingErr := json.Unmarshal(resp.Body, &transfer)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return transfer, resp, nil
+}
+
+type Wallet_DustLog_Params struct {
+	StartTime  int64
+	EndTime    int64
+	RecvWindow int64
+}
+
+// # DustLog
+//
+// Only the last 100 records are returned, records before 2020/12/01 are not returned.
+//
+// Weight(IP): 1
+func (wallet *Wallet) DustLog(opt_params ...Wallet_DustLog_Params) (*Wallet_DustLog, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.StartTime) {
+			opts["startTime"] = params.StartTime
+		}
+		if IsDifferentFromDefault(params.EndTime) {
+			opts["endTime"] = params.EndTime
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/asset/dribblet",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var dustLog *Wallet_DustLog
+	processingErr := json.Unmarshal(resp.Body, &dustLog)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return dustLog, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+type Wallet_FundingWallet_Params struct {
+	Asset            string
+	NeedBtcValuation bool
+	RecvWindow       int64
+}
+
+// # Funding Wallet
+//
+// Weight(IP): 1
+func (wallet *Wallet) FundingWallet(opt_params ...Wallet_FundingWallet_Params) ([]*Wallet_FundingAsset, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(opt_params) != 0 {
+		params := opt_params[0]
+		if IsDifferentFromDefault(params.Asset) {
+			opts["asset"] = params.Asset
+		}
+		if params.NeedBtcValuation {
+			opts["needBtcValuation"] = "true"
+		}
+		if IsDifferentFromDefault(params.RecvWindow) {
+			opts["recvWindow"] = params.RecvWindow
+		}
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.POST,
+		url:          "/sapi/v1/asset/get-funding-asset",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var assets []*Wallet_FundingAsset
+	processingErr := json.Unmarshal(resp.Body, &assets)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return assets, resp, nil
+}
+
+// # Get API Key Permission
+//
+// Weight(IP): 1
+func (wallet *Wallet) APIKeyPermissions(recvWindow ...int64) (*Wallet_APIKeyPermissions, *Response, *Error) {
+	opts := make(map[string]interface{})
+
+	if len(recvWindow) != 0 {
+		opts["recvWindow"] = recvWindow[0]
+	}
+
+	resp, err := wallet.makeRequest(&WalletRequest{
+		securityType: WALLET_Constants.SecurityTypes.USER_DATA,
+		method:       Constants.Methods.GET,
+		url:          "/sapi/v1/account/apiRestrictions",
+		params:       opts,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var permissions *Wallet_APIKeyPermissions
+	processingErr := json.Unmarshal(resp.Body, &permissions)
+	if processingErr != nil {
+		return nil, resp, LocalError(PARSING_ERR, processingErr.Error())
+	}
+	return permissions, resp, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+/////////////////////////////////////////////////////////////////////////////////
+
+type WalletRequest struct {
+	method       string
+	url          string
+	params       map[string]interface{}
+	securityType string
+}
+
+func (wallet *Wallet) makeRequest(request *WalletRequest) (*Response, *Error) {
+
+	switch request.securityType {
+	case WALLET_Constants.SecurityTypes.NONE:
+		return wallet.requestClient.Unsigned(request.method, wallet.baseURL, request.url, request.params)
+
+	case WALLET_Constants.SecurityTypes.USER_DATA:
+		return wallet.requestClient.Signed(request.method, wallet.baseURL, request.url, request.params)
+
+	default:
+		panic(fmt.Sprintf("Security Type passed to Request function is invalid, received: '%s'\nSupported methods are ('%s', '%s')", request.securityType, WALLET_Constants.SecurityTypes.NONE, WALLET_Constants.SecurityTypes.USER_DATA))
+	}
+
+}
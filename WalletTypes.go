@@ -0,0 +1,375 @@
+package Binance
+
+var WALLET_Constants = struct {
+	URLs                [1]string
+	SecurityTypes       Wallet_SecurityTypes_ENUM
+	SystemStatuses      Wallet_SystemStatuses_ENUM
+	DepositStatuses     Wallet_DepositStatuses_ENUM
+	WithdrawStatuses    Wallet_WithdrawStatuses_ENUM
+	TransferTypes       Wallet_TransferTypes_ENUM
+	RateLimits          Wallet_RateLimits
+	WithdrawWalletTypes Wallet_WithdrawWalletTypes_ENUM
+}{
+	URLs: [1]string{"https://api.binance.com"},
+	SecurityTypes: Wallet_SecurityTypes_ENUM{
+		NONE:      "NONE",
+		USER_DATA: "USER_DATA",
+	},
+	SystemStatuses: Wallet_SystemStatuses_ENUM{
+		NORMAL:      0,
+		MAINTENANCE: 1,
+	},
+	DepositStatuses: Wallet_DepositStatuses_ENUM{
+		PENDING:                   0,
+		SUCCESS:                   1,
+		REJECTED:                  2,
+		CREDITED_CANNOT_WITHDRAW:  6,
+		WRONG_DEPOSIT:             7,
+		WAITING_USER_CONFIRMATION: 8,
+	},
+	WithdrawStatuses: Wallet_WithdrawStatuses_ENUM{
+		EMAIL_SENT:        0,
+		CANCELLED:         1,
+		AWAITING_APPROVAL: 2,
+		REJECTED:          3,
+		PROCESSING:        4,
+		FAILURE:           5,
+		COMPLETED:         6,
+	},
+	TransferTypes: Wallet_TransferTypes_ENUM{
+		MAIN_UMFUTURE:                 "MAIN_UMFUTURE",
+		MAIN_CMFUTURE:                 "MAIN_CMFUTURE",
+		MAIN_MARGIN:                   "MAIN_MARGIN",
+		MAIN_FUNDING:                  "MAIN_FUNDING",
+		MAIN_OPTION:                   "MAIN_OPTION",
+		UMFUTURE_MAIN:                 "UMFUTURE_MAIN",
+		UMFUTURE_MARGIN:               "UMFUTURE_MARGIN",
+		UMFUTURE_FUNDING:              "UMFUTURE_FUNDING",
+		UMFUTURE_OPTION:               "UMFUTURE_OPTION",
+		CMFUTURE_MAIN:                 "CMFUTURE_MAIN",
+		CMFUTURE_MARGIN:               "CMFUTURE_MARGIN",
+		CMFUTURE_FUNDING:              "CMFUTURE_FUNDING",
+		MARGIN_MAIN:                   "MARGIN_MAIN",
+		MARGIN_UMFUTURE:               "MARGIN_UMFUTURE",
+		MARGIN_CMFUTURE:               "MARGIN_CMFUTURE",
+		MARGIN_FUNDING:                "MARGIN_FUNDING",
+		MARGIN_OPTION:                 "MARGIN_OPTION",
+		ISOLATEDMARGIN_MARGIN:         "ISOLATEDMARGIN_MARGIN",
+		MARGIN_ISOLATEDMARGIN:         "MARGIN_ISOLATEDMARGIN",
+		ISOLATEDMARGIN_ISOLATEDMARGIN: "ISOLATEDMARGIN_ISOLATEDMARGIN",
+		FUNDING_MAIN:                  "FUNDING_MAIN",
+		FUNDING_UMFUTURE:              "FUNDING_UMFUTURE",
+		FUNDING_CMFUTURE:              "FUNDING_CMFUTURE",
+		FUNDING_MARGIN:                "FUNDING_MARGIN",
+		FUNDING_OPTION:                "FUNDING_OPTION",
+		OPTION_MAIN:                   "OPTION_MAIN",
+		OPTION_UMFUTURE:               "OPTION_UMFUTURE",
+		OPTION_MARGIN:                 "OPTION_MARGIN",
+		OPTION_FUNDING:                "OPTION_FUNDING",
+		MAIN_PORTFOLIO_MARGIN:         "MAIN_PORTFOLIO_MARGIN",
+		PORTFOLIO_MARGIN_MAIN:         "PORTFOLIO_MARGIN_MAIN",
+	},
+	RateLimits: Wallet_RateLimits{
+		SAPI_IP_WEIGHT_PER_MINUTE:  12000,
+		SAPI_UID_WEIGHT_PER_MINUTE: 180000,
+	},
+	WithdrawWalletTypes: Wallet_WithdrawWalletTypes_ENUM{
+		SPOT:    0,
+		FUNDING: 1,
+	},
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////// Declarations
+//////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////// Definitions
+
+type Wallet_SecurityTypes_ENUM struct {
+	NONE      string
+	USER_DATA string
+}
+
+type Wallet_SystemStatuses_ENUM struct {
+	NORMAL      int64
+	MAINTENANCE int64
+}
+
+type Wallet_DepositStatuses_ENUM struct {
+	PENDING                   int64
+	SUCCESS                   int64
+	REJECTED                  int64
+	CREDITED_CANNOT_WITHDRAW  int64
+	WRONG_DEPOSIT             int64
+	WAITING_USER_CONFIRMATION int64
+}
+
+type Wallet_WithdrawStatuses_ENUM struct {
+	EMAIL_SENT        int64
+	CANCELLED         int64
+	AWAITING_APPROVAL int64
+	REJECTED          int64
+	PROCESSING        int64
+	FAILURE           int64
+	COMPLETED         int64
+}
+
+// "MAIN" is the spot wallet, "UMFUTURE" is USDⓈ-M Futures, "CMFUTURE" is COIN-M Futures
+type Wallet_TransferTypes_ENUM struct {
+	MAIN_UMFUTURE                 string
+	MAIN_CMFUTURE                 string
+	MAIN_MARGIN                   string
+	MAIN_FUNDING                  string
+	MAIN_OPTION                   string
+	UMFUTURE_MAIN                 string
+	UMFUTURE_MARGIN               string
+	UMFUTURE_FUNDING              string
+	UMFUTURE_OPTION               string
+	CMFUTURE_MAIN                 string
+	CMFUTURE_MARGIN               string
+	CMFUTURE_FUNDING              string
+	MARGIN_MAIN                   string
+	MARGIN_UMFUTURE               string
+	MARGIN_CMFUTURE               string
+	MARGIN_FUNDING                string
+	MARGIN_OPTION                 string
+	ISOLATEDMARGIN_MARGIN         string
+	MARGIN_ISOLATEDMARGIN         string
+	ISOLATEDMARGIN_ISOLATEDMARGIN string
+	FUNDING_MAIN                  string
+	FUNDING_UMFUTURE              string
+	FUNDING_CMFUTURE              string
+	FUNDING_MARGIN                string
+	FUNDING_OPTION                string
+	OPTION_MAIN                   string
+	OPTION_UMFUTURE               string
+	OPTION_MARGIN                 string
+	OPTION_FUNDING                string
+	MAIN_PORTFOLIO_MARGIN         string
+	PORTFOLIO_MARGIN_MAIN         string
+}
+
+// SAPI endpoints are limited either by IP or by UID (account), each endpoint's doc states which one applies
+//
+// The used weights are returned by Response.GetSAPIUsedIPWeight("1m") and Response.GetSAPIUsedUIDWeight("1m")
+type Wallet_RateLimits struct {
+	SAPI_IP_WEIGHT_PER_MINUTE  int64
+	SAPI_UID_WEIGHT_PER_MINUTE int64
+}
+
+type Wallet_WithdrawWalletTypes_ENUM struct {
+	SPOT    int64
+	FUNDING int64
+}
+
+//////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type Wallet_SystemStatus struct {
+	// 0: normal, 1: system maintenance
+	Status int64 `json:"status"`
+	// "normal" | "system_maintenance"
+	Msg string `json:"msg"`
+}
+
+type Wallet_Coin struct {
+	Coin              string                 `json:"coin"`
+	Name              string                 `json:"name"`
+	DepositAllEnable  bool                   `json:"depositAllEnable"`
+	WithdrawAllEnable bool                   `json:"withdrawAllEnable"`
+	Free              string                 `json:"free"`
+	Freeze            string                 `json:"freeze"`
+	Ipoable           string                 `json:"ipoable"`
+	Ipoing            string                 `json:"ipoing"`
+	IsLegalMoney      bool                   `json:"isLegalMoney"`
+	Locked            string                 `json:"locked"`
+	Storage           string                 `json:"storage"`
+	Trading           bool                   `json:"trading"`
+	Withdrawing       string                 `json:"withdrawing"`
+	NetworkList       []*Wallet_Coin_Network `json:"networkList"`
+}
+
+type Wallet_Coin_Network struct {
+	Network        string `json:"network"`
+	Coin           string `json:"coin"`
+	Name           string `json:"name"`
+	IsDefault      bool   `json:"isDefault"`
+	AddressRegex   string `json:"addressRegex"`
+	MemoRegex      string `json:"memoRegex"`
+	DepositEnable  bool   `json:"depositEnable"`
+	DepositDesc    string `json:"depositDesc"`
+	WithdrawEnable bool   `json:"withdrawEnable"`
+	WithdrawDesc   string `json:"withdrawDesc"`
+	SpecialTips    string `json:"specialTips"`
+	// Minimum number of confirmations for the balance to be credited
+	MinConfirm int64 `json:"minConfirm"`
+	// Confirmations for the deposit to be unlocked for withdrawals
+	UnLockConfirm           int64  `json:"unLockConfirm"`
+	WithdrawFee             string `json:"withdrawFee"`
+	WithdrawMin             string `json:"withdrawMin"`
+	WithdrawMax             string `json:"withdrawMax"`
+	WithdrawIntegerMultiple string `json:"withdrawIntegerMultiple"`
+	// If the coin needs to provide a memo to withdraw
+	SameAddress bool `json:"sameAddress"`
+	// In minutes
+	EstimatedArrivalTime int64  `json:"estimatedArrivalTime"`
+	Busy                 bool   `json:"busy"`
+	ContractAddressUrl   string `json:"contractAddressUrl"`
+	ContractAddress      string `json:"contractAddress"`
+}
+
+type Wallet_DepositAddress struct {
+	Address string `json:"address"`
+	Coin    string `json:"coin"`
+	Tag     string `json:"tag"`
+	Url     string `json:"url"`
+}
+
+type Wallet_Deposit struct {
+	Id      string `json:"id"`
+	Amount  string `json:"amount"`
+	Coin    string `json:"coin"`
+	Network string `json:"network"`
+	// One of WALLET_Constants.DepositStatuses
+	Status     int64  `json:"status"`
+	Address    string `json:"address"`
+	AddressTag string `json:"addressTag"`
+	TxId       string `json:"txId"`
+	InsertTime int64  `json:"insertTime"`
+	// 1 for internal transfer, 0 for external transfer
+	TransferType int64 `json:"transferType"`
+	// Confirmation times, i.e: "12/12"
+	ConfirmTimes  string `json:"confirmTimes"`
+	UnlockConfirm int64  `json:"unlockConfirm"`
+	// 0: spot wallet, 1: funding wallet
+	WalletType   int64 `json:"walletType"`
+	CompleteTime int64 `json:"completeTime"`
+}
+
+type Wallet_WithdrawId struct {
+	Id string `json:"id"`
+}
+
+type Wallet_Withdrawal struct {
+	Id             string `json:"id"`
+	Amount         string `json:"amount"`
+	TransactionFee string `json:"transactionFee"`
+	Coin           string `json:"coin"`
+	// One of WALLET_Constants.WithdrawStatuses
+	Status          int64  `json:"status"`
+	Address         string `json:"address"`
+	TxId            string `json:"txId"`
+	ApplyTime       string `json:"applyTime"`
+	Network         string `json:"network"`
+	TransferType    int64  `json:"transferType"`
+	WithdrawOrderId string `json:"withdrawOrderId"`
+	// Reason for withdrawal failure
+	Info string `json:"info"`
+	// Confirmation times for the withdrawal
+	ConfirmNo int64 `json:"confirmNo"`
+	// 0: spot wallet, 1: funding wallet
+	WalletType   int64  `json:"walletType"`
+	TxKey        string `json:"txKey"`
+	CompleteTime string `json:"completeTime"`
+}
+
+type Wallet_Transaction struct {
+	TranId int64 `json:"tranId"`
+}
+
+type Wallet_TransferHistory struct {
+	Total int64                    `json:"total"`
+	Rows  []*Wallet_TransferRecord `json:"rows"`
+}
+
+type Wallet_TransferRecord struct {
+	Asset  string `json:"asset"`
+	Amount string `json:"amount"`
+	Type   string `json:"type"`
+	// "CONFIRMED" | "FAILED" | "PENDING"
+	Status    string `json:"status"`
+	TranId    int64  `json:"tranId"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+type Wallet_AssetDetail struct {
+	MinWithdrawAmount string `json:"minWithdrawAmount"`
+	DepositStatus     bool   `json:"depositStatus"`
+	WithdrawFee       string `json:"withdrawFee"`
+	WithdrawStatus    bool   `json:"withdrawStatus"`
+	// Reason
+	DepositTip string `json:"depositTip"`
+}
+
+type Wallet_TradeFee struct {
+	Symbol          string `json:"symbol"`
+	MakerCommission string `json:"makerCommission"`
+	TakerCommission string `json:"takerCommission"`
+}
+
+type Wallet_DustAssets struct {
+	Details            []*Wallet_DustAsset `json:"details"`
+	TotalTransferBtc   string              `json:"totalTransferBtc"`
+	TotalTransferBNB   string              `json:"totalTransferBNB"`
+	DribbletPercentage string              `json:"dribbletPercentage"`
+}
+
+type Wallet_DustAsset struct {
+	Asset            string `json:"asset"`
+	AssetFullName    string `json:"assetFullName"`
+	AmountFree       string `json:"amountFree"`
+	ToBTC            string `json:"toBTC"`
+	ToBNB            string `json:"toBNB"`
+	ToBNBOffExchange string `json:"toBNBOffExchange"`
+	Exchange         string `json:"exchange"`
+}
+
+type Wallet_DustTransfer struct {
+	TotalServiceCharge string                        `json:"totalServiceCharge"`
+	TotalTransfered    string                        `json:"totalTransfered"`
+	TransferResult     []*Wallet_DustTransfer_Result `json:"transferResult"`
+}
+
+type Wallet_DustTransfer_Result struct {
+	Amount              string `json:"amount"`
+	FromAsset           string `json:"fromAsset"`
+	OperateTime         int64  `json:"operateTime"`
+	ServiceChargeAmount string `json:"serviceChargeAmount"`
+	TranId              int64  `json:"tranId"`
+	TransferedAmount    string `json:"transferedAmount"`
+}
+
+type Wallet_DustLog struct {
+	Total              int64                      `json:"total"`
+	UserAssetDribblets []*Wallet_DustLog_Dribblet `json:"userAssetDribblets"`
+}
+
+type Wallet_DustLog_Dribblet struct {
+	OperateTime              int64                         `json:"operateTime"`
+	TotalTransferedAmount    string                        `json:"totalTransferedAmount"`
+	TotalServiceChargeAmount string                        `json:"totalServiceChargeAmount"`
+	TransId                  int64                         `json:"transId"`
+	UserAssetDribbletDetails []*Wallet_DustTransfer_Result `json:"userAssetDribbletDetails"`
+}
+
+type Wallet_FundingAsset struct {
+	Asset        string `json:"asset"`
+	Free         string `json:"free"`
+	Locked       string `json:"locked"`
+	Freeze       string `json:"freeze"`
+	Withdrawing  string `json:"withdrawing"`
+	BtcValuation string `json:"btcValuation"`
+}
+
+type Wallet_APIKeyPermissions struct {
+	IpRestrict                   bool  `json:"ipRestrict"`
+	CreateTime                   int64 `json:"createTime"`
+	EnableReading                bool  `json:"enableReading"`
+	EnableSpotAndMarginTrading   bool  `json:"enableSpotAndMarginTrading"`
+	EnableWithdrawals            bool  `json:"enableWithdrawals"`
+	EnableInternalTransfer       bool  `json:"enableInternalTransfer"`
+	EnableMargin                 bool  `json:"enableMargin"`
+	EnableFutures                bool  `json:"enableFutures"`
+	PermitsUniversalTransfer     bool  `json:"permitsUniversalTransfer"`
+	EnableVanillaOptions         bool  `json:"enableVanillaOptions"`
+	EnablePortfolioMarginTrading bool  `json:"enablePortfolioMarginTrading"`
+	// Expiration time for the spot and margin trading permission, only set if the key isn't IP restricted
+	TradingAuthorityExpirationTime int64 `json:"tradingAuthorityExpirationTime"`
+}
@@ -1,50 +1,408 @@
 package Binance
 
 import (
+	"errors"
 	"fmt"
+	"math/rand"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	ws "github.com/gorilla/websocket"
 	jsoniter "github.com/json-iterator/go"
 )
 
+// # Websocket
+//
+// Keeps a single connection open to "BaseURL", replaced by a new one on every reconnection.
+//
+// Each connection has its own reader, writer and heartbeat goroutines,
+// every write (requests, pings, pongs, close frames) goes through the writer's queue, as gorilla's connections only support one concurrent writer.
+//
+// The readers hand their messages to the socket's single dispatch goroutine, the listeners are never called concurrently, even while rotating.
+//
+// The state, streams and listeners are guarded, every method is safe to call from any goroutine.
+//
+// # Breaking changes
+//
+// The fields that could not be guarded were removed:
+//
+// - "Conn": the connection changes on every reconnection and rotation, requests go through SendRequest_sync(), see the deprecated Conn() for reading it
+//
+// - "Streams": replaced by the Streams() method, the streams are changed with the Spot/Futures sockets' Subscribe()/Unsubscribe()
+//
+// - "OnPrivateMessage", "OnMessage", "OnPing", "OnPong", "OnDisconnect", "OnReconnecting", "OnReconnect" and "OnClose": replaced by their Set...Listener() methods
+//
+// - "Last_Heartbeat_Timestamp": replaced by LastHeartbeat(), see the deprecated GetLast_Heartbeat_Timestamp()
 type Websocket struct {
-	Conn *ws.Conn
 	// Host server's URL
 	BaseURL string
-	Streams []string
 
 	// This is to show the current state of the stream
 	// false -> it's a raw stream
 	// true -> it's a combined stream
 	IsCombined bool
 
-	// This happens when a response for a request has been received
-	// This will get called first, even before the requesting function receives a response
-	OnPrivateMessage func(msg []byte)
-	OnMessage        func(messageType int, msg []byte)
-	OnPing           func(appData string)
-	OnPong           func(appData string)
-	OnDisconnect     func(code int, text string)
-	OnReconnecting   func()
-	OnReconnect      func()
-	OnClose          func(code int, text string)
+	Creation_Timestamp int64
+
+	mu sync.Mutex
+	// One of Constants.WebsocketStates
+	state      string
+	connection *websocketConnection
+	streams    []string
+	handlers   websocketHandlers
+	// Zero values fall back to the Constants.Websocket.RECONNECT_* defaults
+	reconnectPolicy Websocket_ReconnectPolicy
+	// Stops the running reconciliation, nil if there is none
+	reconcileStop chan struct{}
+
+	// Held while (un)subscribing and reconciling, so that a reconciliation never sees a request in flight
+	subscriptionsMu sync.Mutex
+
+	// Closed once the socket is closed, interrupts the reconnection delays
+	closing     chan struct{}
+	closingOnce sync.Once
+
+	pendingMu       sync.Mutex
+	pendingRequests map[string]chan []byte
+
+	// Unix time in seconds
+	lastHeartbeat atomic.Int64
+
+	// Set while a replacement connection overlaps the current one
+	rotation atomic.Pointer[websocketRotation]
+
+	// Messages of every connection, in the order they are read, see dispatchLoop()
+	frames chan websocketFrame
 
-	Creation_Timestamp       int64
-	Last_Heartbeat_Timestamp int64
+	// Every goroutine of the socket, they have all returned once it's closed
+	routines sync.WaitGroup
+}
+
+// A message read by one of the socket's connections
+type websocketFrame struct {
+	connection *websocketConnection
+	msgType    int
+	msg        []byte
 
-	isReconnecting bool
+	// Only set, without a message, once a rotation switched: the replacement's held events are delivered
+	switched *websocketRotation
+	// Only set, without a message: called by the dispatch goroutine, see runOnDispatch()
+	run func()
+}
 
-	reconnect bool
-	closed    bool
+type websocketHandlers struct {
+	// This happens when a response for a request has been received
+	// Called right after the requesting function receives its response
+	onPrivateMessage func(msg []byte)
+	onMessage        func(messageType int, msg []byte)
+	// Receives the stream's name with its data, see SetStreamMessageListener()
+	onStreamMessage func(stream string, msg []byte)
+	// Decodes the data of the typed sockets (and the stream manager's), called before the listeners
+	streamDecoder  func(stream string, msg []byte)
+	onError        func(err *WebsocketDecodeError)
+	onDrift        func(drift *SubscriptionDrift)
+	onPing         func(appData string)
+	onPong         func(appData string)
+	onDisconnect   func(code int, text string)
+	onReconnecting func()
+	onReconnect    func()
+	onClose        func(code int, text string)
 
 	privateMessageValidator func(msg []byte) (isPrivate bool, Id string)
-	pendingRequests         map[string]chan []byte
 
-	subsocket_id int64
+	// Called right before dialing a reconnection
+	// Allows the owner of the socket to update the streams (i.e: a renewed listenKey)
+	beforeReconnect func()
+	// Called once a reconnection is open, right after the reconnect listener
+	afterReconnect func()
+}
+
+// A dialed connection, owned by a single Websocket
+type websocketConnection struct {
+	conn   *ws.Conn
+	writes chan *websocketWrite
+	// Pings, pongs and close frames, written before any queued message
+	controls chan *websocketWrite
+	// Times of the last writes, Binance drops connections sending more than MAX_OUTGOING_MESSAGES_PER_SECOND
+	sent []time.Time
+
+	// Closed when the connection is torn down, stops its goroutines
+	done      chan struct{}
+	closeOnce sync.Once
+
+	createdAt time.Time
+	rotating  atomic.Bool
+	// Set once a rotation stopped using it, its messages still being dispatched are dropped
+	retired atomic.Bool
+}
+
+// # 24 hour rotation
+//
+// Binance drops connections after 24 hours, so a replacement connection is opened with the same streams once the current one reaches EXPECTED_DISCONNECTION_TIME_SEC.
+//
+// Both connections are read for ROTATION_OVERLAP_SEC, then the old one is closed. Each stream is handed over separately, by the position of its events:
+// the update id (depth, bookTicker), the trade id (aggTrade, trade) or else the event time, see eventPosition().
+//
+// Events at or before the last delivered position of their stream are dropped, so the listeners receive every event once and in order:
+//
+// - The replacement's events are held until the old connection goes past its first one, it may be missing the ones in between until then
+//
+// - The old connection is kept until every stream can be handed over (up to Constants.Websocket.ROTATION_MAX_OVERLAPS overlaps)
+//
+// - Once it's closed, the replacement's events are still filtered until it caught up with every stream
+type websocketRotation struct {
+	replacement *websocketConnection
+
+	mu sync.Mutex
+	// Set once the replacement is the socket's connection
+	switched bool
+	streams  map[string]*rotationStream
+	// The rotation still filtering when this one started, its positions are taken over by the first accept()
+	previous *websocketRotation
+}
+
+type rotationStream struct {
+	// Position of the last delivered event
+	last int64
+	// Events received at "last" by the old connection [0] and the replacement [1]
+	// Both connections receive the same events in the same order, so an event at "last" is only new once a connection received more of them than were delivered
+	receivedAtLast  [2]int
+	deliveredAtLast int
+
+	// First position received from the replacement, 0 until then
+	replacementFirst int64
+	// Events of the replacement waiting for the old connection to go past "replacementFirst"
+	held []rotationFrame
+	// Set once the replacement delivered an event
+	caughtUp bool
+}
+
+type rotationFrame struct {
+	msgType  int
+	stream   string
+	data     []byte
+	position int64
+}
+
+// Returns the events to deliver, in order: the message if it wasn't delivered by the other connection yet, and the replacement's events it releases
+//
+// Called by the dispatch goroutine only
+func (rotation *websocketRotation) accept(isReplacement bool, msgType int, stream string, data []byte) []rotationFrame {
+	frame := rotationFrame{msgType: msgType, stream: stream, data: data, position: eventPosition(data)}
+
+	rotation.mu.Lock()
+	defer rotation.mu.Unlock()
+
+	var delivered []rotationFrame
+	if rotation.previous != nil {
+		delivered = rotation.inherit(rotation.previous)
+		rotation.previous = nil
+	}
+
+	if frame.position == 0 {
+		// Nothing to compare, the old connection keeps delivering until it's closed
+		if isReplacement == rotation.switched {
+			delivered = append(delivered, frame)
+		}
+		return delivered
+	}
+
+	state, exists := rotation.streams[stream]
+	if !exists {
+		state = &rotationStream{}
+		rotation.streams[stream] = state
+	}
+
+	if !isReplacement {
+		if !state.deliver(0, frame.position) {
+			return delivered
+		}
+		delivered = append(delivered, frame)
+		if state.isTrusted(rotation.switched) {
+			delivered = append(delivered, state.release()...)
+		}
+		return delivered
+	}
+
+	if state.replacementFirst == 0 {
+		state.replacementFirst = frame.position
+	}
+	state.held = append(state.held, frame)
+	if !state.isTrusted(rotation.switched) {
+		return delivered
+	}
+	return append(delivered, state.release()...)
+}
+
+// Once the old connection went past the replacement's first event, the replacement has every event that wasn't delivered yet
+func (state *rotationStream) isTrusted(switched bool) bool {
+	return switched || (state.replacementFirst != 0 && state.last > state.replacementFirst)
+}
+
+// Returns the held events of the replacement that weren't delivered by the old connection
+func (state *rotationStream) release() []rotationFrame {
+	var delivered []rotationFrame
+	for _, frame := range state.held {
+		if state.deliver(1, frame.position) {
+			delivered = append(delivered, frame)
+			state.caughtUp = true
+		}
+	}
+	state.held = nil
+
+	return delivered
+}
+
+// Returns true if the event at "position" received by the old connection [0] or the replacement [1] wasn't delivered yet, it's then counted as delivered
+func (state *rotationStream) deliver(index int, position int64) bool {
+	switch {
+	case position < state.last:
+		return false
+
+	case position == state.last:
+		state.receivedAtLast[index]++
+		if state.receivedAtLast[index] <= state.deliveredAtLast {
+			return false
+		}
+		state.deliveredAtLast = state.receivedAtLast[index]
+		return true
+
+	default:
+		state.last = position
+		state.receivedAtLast = [2]int{}
+		state.receivedAtLast[index] = 1
+		state.deliveredAtLast = 1
+		return true
+	}
+}
+
+// Returns the events still held once the rotation switched, the old connection won't deliver them
+//
+// Called by the dispatch goroutine only
+func (rotation *websocketRotation) releaseAll() []rotationFrame {
+	rotation.mu.Lock()
+	defer rotation.mu.Unlock()
+
+	var delivered []rotationFrame
+	for _, state := range rotation.streams {
+		delivered = append(delivered, state.release()...)
+	}
+
+	return delivered
+}
+
+// Continues from the positions of "previous", whose replacement is the connection being rotated
+//
+// "previous" no longer receives messages once this rotation is stored, the dispatch goroutine being the only one calling accept().
+// Returns the events it still held, it may not have released them yet.
+func (rotation *websocketRotation) inherit(previous *websocketRotation) []rotationFrame {
+	previous.mu.Lock()
+	defer previous.mu.Unlock()
+
+	var released []rotationFrame
+	for stream, state := range previous.streams {
+		released = append(released, state.release()...)
+
+		rotation.streams[stream] = &rotationStream{
+			last:            state.last,
+			receivedAtLast:  [2]int{state.receivedAtLast[1], 0},
+			deliveredAtLast: state.deliveredAtLast,
+		}
+	}
+
+	return released
+}
+
+// Returns true once every stream received by the replacement can be handed over without a gap
+func (rotation *websocketRotation) canSwitch() bool {
+	rotation.mu.Lock()
+	defer rotation.mu.Unlock()
+
+	for _, state := range rotation.streams {
+		if state.replacementFirst != 0 && state.last <= state.replacementFirst {
+			return false
+		}
+	}
+	return true
+}
+
+func (rotation *websocketRotation) setSwitched() {
+	rotation.mu.Lock()
+	defer rotation.mu.Unlock()
+
+	rotation.switched = true
+}
+
+func (rotation *websocketRotation) hasSwitched() bool {
+	rotation.mu.Lock()
+	defer rotation.mu.Unlock()
+
+	return rotation.switched
+}
+
+// Returns true once the replacement is the socket's connection and delivered every stream, the events no longer need to be filtered
+func (rotation *websocketRotation) isDone() bool {
+	rotation.mu.Lock()
+	defer rotation.mu.Unlock()
+
+	if !rotation.switched {
+		return false
+	}
+	for _, state := range rotation.streams {
+		if !state.caughtUp {
+			return false
+		}
+	}
+	return true
 }
 
+// # Position of an event in its stream, 0 if it has none
+//
+// The update id of depth and bookTicker events, the trade id of aggTrade and trade events,
+// the event time for the others ("E", of the first item for the array streams, i.e: "!miniTicker@arr")
+func eventPosition(data []byte) int64 {
+	if len(data) != 0 && data[0] == '[' {
+		return numberAt(data, 0, "E")
+	}
+
+	switch json.Get(data, "e").ToString() {
+	case "depthUpdate", "bookTicker":
+		return numberAt(data, "u")
+	case "aggTrade":
+		return numberAt(data, "a")
+	case "trade":
+		return numberAt(data, "t")
+	case "":
+		// Spot's partial depth and bookTicker streams carry no event type
+		if position := numberAt(data, "lastUpdateId"); position != 0 {
+			return position
+		}
+		return numberAt(data, "u")
+	}
+
+	return numberAt(data, "E")
+}
+
+func numberAt(data []byte, path ...interface{}) int64 {
+	value := json.Get(data, path...)
+	if value.ValueType() != jsoniter.NumberValue {
+		return 0
+	}
+	return value.ToInt64()
+}
+
+type websocketWrite struct {
+	messageType int
+	data        []byte
+	// nil for writes nobody waits for (pongs, pings)
+	result chan error
+}
+
+var errWebsocketConnectionClosed = errors.New("the connection is closed")
+
 type CombinedStream_MSG struct {
 	Stream string              `json:"stream"`
 	Data   jsoniter.RawMessage `json:"data"`
@@ -55,41 +413,81 @@ func CreateSocket(baseURL string, streams []string, isCombined bool) (*Websocket
 		isCombined = true
 	}
 
-	queryStr := CreateQueryStringWS(streams, isCombined)
-
-	fullStreamStr := baseURL + queryStr
+	websocket := &Websocket{
+		BaseURL:            baseURL,
+		IsCombined:         isCombined,
+		Creation_Timestamp: time.Now().Unix(),
+		state:              Constants.WebsocketStates.CONNECTING,
+		streams:            append([]string(nil), streams...),
+		pendingRequests:    make(map[string]chan []byte),
+		closing:            make(chan struct{}),
+		frames:             make(chan websocketFrame),
+	}
 
-	conn, _, err := ws.DefaultDialer.Dial(fullStreamStr, nil)
+	websocketConnectionBudget.wait(nil)
+	connection, err := websocket.dial()
 	if err != nil {
 		LOG_WS_ERRORS("There was an error creating websocket:", err)
 		return nil, LocalError(WS_OPEN_ERR, err.Error())
 	}
 
-	LOG_WS_VERBOSE("Socket connected:", queryStr)
+	websocket.mu.Lock()
+	websocket.connection = connection
+	websocket.state = Constants.WebsocketStates.OPEN
+	websocket.mu.Unlock()
 
-	currentTime := time.Now().Unix()
+	websocket.RecordLastHeartbeat()
+	websocket.goroutine(websocket.dispatchLoop)
+	websocket.start(connection)
 
-	websocket := &Websocket{
-		Conn:                     conn,
-		BaseURL:                  baseURL,
-		Streams:                  streams,
-		IsCombined:               isCombined,
-		Creation_Timestamp:       currentTime,
-		Last_Heartbeat_Timestamp: currentTime,
-		reconnect:                true,
-		closed:                   false,
-		pendingRequests:          make(map[string]chan []byte),
+	LOG_WS_VERBOSE("Socket connected:", CreateQueryStringWS(streams, isCombined))
+
+	return websocket, nil
+}
+
+func CreateQueryStringWS(streams []string, isCombined bool) string {
+	streamsStr := ""
+	if isCombined {
+		streamsStr += "/stream?streams=" + strings.Join(streams, "/")
+	} else {
+		streamsStr += "/ws/" + streams[0]
 	}
 
-	setUpSocket(websocket, conn)
+	return streamsStr
+}
 
-	return websocket, nil
+func (websocket *Websocket) dial() (*websocketConnection, error) {
+	queryStr := CreateQueryStringWS(websocket.Streams(), websocket.IsCombined)
+
+	conn, _, err := ws.DefaultDialer.Dial(websocket.BaseURL+queryStr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &websocketConnection{
+		conn:      conn,
+		writes:    make(chan *websocketWrite, Constants.Websocket.WRITE_QUEUE_SIZE),
+		controls:  make(chan *websocketWrite, 8),
+		done:      make(chan struct{}),
+		createdAt: time.Now(),
+	}, nil
 }
 
-func setUpSocket(websocket *Websocket, conn *ws.Conn) {
-	conn.SetCloseHandler(websocket.CloseHandler)
-	conn.SetPingHandler(websocket.PingHandler)
-	conn.SetPongHandler(websocket.PongHandler)
+// Starts the connection's goroutines, must be called once the connection is the socket's current one
+func (websocket *Websocket) start(connection *websocketConnection) {
+	connection.conn.SetPingHandler(func(appData string) error {
+		websocket.handlePing(connection, appData)
+		return nil
+	})
+	connection.conn.SetPongHandler(func(appData string) error {
+		websocket.handlePong(appData)
+		return nil
+	})
+	connection.conn.SetCloseHandler(func(code int, text string) error {
+		// Answered through the writer, the reader then returns the close error and the socket reconnects
+		connection.tryWrite(ws.CloseMessage, ws.FormatCloseMessage(code, ""))
+		return nil
+	})
 
 	// Handle system interrupts to close the connection gracefully
 	// interrupt := make(chan os.Signal, 1)
@@ -97,113 +495,855 @@ func setUpSocket(websocket *Websocket, conn *ws.Conn) {
 	// Not sure how to best do this, so will leave it empty for now
 	// TODO
 
-	current_subSocketID := websocket.subsocket_id
+	websocket.goroutine(connection.writeLoop)
+	websocket.goroutine(func() { websocket.readLoop(connection) })
+	websocket.goroutine(func() { websocket.heartbeatLoop(connection) })
+}
 
-	// Goroutine to read messages
+// Runs "f" in a goroutine of the socket, see "routines"
+func (websocket *Websocket) goroutine(f func()) {
+	websocket.routines.Add(1)
 	go func() {
-		for {
-			if websocket.closed {
+		defer websocket.routines.Done()
+		f()
+	}()
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// The only goroutine writing to the connection
+//
+// Every frame counts towards Binance's outgoing message limit, the writer waits for a free slot before picking the next one, control frames first.
+func (connection *websocketConnection) writeLoop() {
+	for {
+		if !connection.waitForSlot() {
+			return
+		}
+
+		var write *websocketWrite
+		select {
+		case write = <-connection.controls:
+		default:
+			select {
+			case <-connection.done:
 				return
+			case write = <-connection.controls:
+			case write = <-connection.writes:
 			}
-			if websocket.isReconnecting {
-				continue
+		}
+
+		connection.conn.SetWriteDeadline(time.Now().Add(time.Duration(Constants.Websocket.WRITE_TIMEOUT_SEC) * time.Second))
+		err := connection.conn.WriteMessage(write.messageType, write.data)
+		connection.sent = append(connection.sent, time.Now())
+		if write.result != nil {
+			write.result <- err
+		}
+
+		// A failed write leaves the connection unusable, the reader notices it and the socket reconnects
+		if err != nil {
+			LOG_WS_ERRORS("Error writing message:", err)
+			connection.close()
+			return
+		}
+	}
+}
+
+// Waits until less than MAX_OUTGOING_MESSAGES_PER_SECOND were written in the last second, returns false if the connection was closed meanwhile
+func (connection *websocketConnection) waitForSlot() bool {
+	limit := int(Constants.Websocket.MAX_OUTGOING_MESSAGES_PER_SECOND)
+
+	for len(connection.sent) >= limit {
+		wait := time.Until(connection.sent[len(connection.sent)-limit].Add(time.Second))
+		if wait <= 0 {
+			connection.sent = connection.sent[len(connection.sent)-limit+1:]
+			continue
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-connection.done:
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+	}
+
+	return true
+}
+
+func isControlMessage(messageType int) bool {
+	return messageType == ws.PingMessage || messageType == ws.PongMessage || messageType == ws.CloseMessage
+}
+
+func (connection *websocketConnection) queue(messageType int) chan *websocketWrite {
+	if isControlMessage(messageType) {
+		return connection.controls
+	}
+	return connection.writes
+}
+
+// Queues the message and waits for it to be written, which can take a while if the outgoing message limit is reached
+func (connection *websocketConnection) write(messageType int, data []byte) error {
+	write := &websocketWrite{
+		messageType: messageType,
+		data:        data,
+		result:      make(chan error, 1),
+	}
+
+	select {
+	case connection.queue(messageType) <- write:
+	case <-connection.done:
+		return errWebsocketConnectionClosed
+	}
+
+	select {
+	case err := <-write.result:
+		return err
+	case <-connection.done:
+		return errWebsocketConnectionClosed
+	}
+}
+
+// Queues the message without waiting, returns false if the queue is full
+func (connection *websocketConnection) tryWrite(messageType int, data []byte) bool {
+	select {
+	case connection.queue(messageType) <- &websocketWrite{messageType: messageType, data: data}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (connection *websocketConnection) close() {
+	connection.closeOnce.Do(func() {
+		close(connection.done)
+		connection.conn.Close()
+	})
+}
+
+func (connection *websocketConnection) isClosed() bool {
+	select {
+	case <-connection.done:
+		return true
+	default:
+		return false
+	}
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+func (websocket *Websocket) readLoop(connection *websocketConnection) {
+	for {
+		msgType, msg, err := connection.conn.ReadMessage()
+		if err != nil {
+			code, text := -1, err.Error()
+
+			var closeErr *ws.CloseError
+			if errors.As(err, &closeErr) {
+				code, text = closeErr.Code, closeErr.Text
 			}
-			msgType, msg, err := conn.ReadMessage()
-			if err != nil {
+
+			// Expected when the connection was torn down on purpose
+			if !connection.isClosed() {
 				LOG_WS_ERRORS("Error reading message:", err)
+			}
 
-				if current_subSocketID != websocket.subsocket_id {
-					return
+			websocket.connectionLost(connection, code, text)
+			return
+		}
+		LOG_WS_MESSAGES(fmt.Sprintf("Type: %d, message: %s\n", msgType, string(msg)))
+
+		websocket.RecordLastHeartbeat()
+
+		select {
+		case websocket.frames <- websocketFrame{connection: connection, msgType: msgType, msg: msg}:
+		case <-connection.done:
+			return
+		case <-websocket.closing:
+			return
+		}
+	}
+}
+
+// The only goroutine calling the listeners, receives the messages of every connection (two of them while rotating)
+func (websocket *Websocket) dispatchLoop() {
+	for {
+		select {
+		case frame := <-websocket.frames:
+			if frame.switched != nil {
+				handlers := websocket.getHandlers()
+				for _, held := range frame.switched.releaseAll() {
+					websocket.deliver(handlers, held.msgType, held.stream, held.data)
 				}
-				time.Sleep(500 * time.Millisecond)
 				continue
 			}
-			LOG_WS_MESSAGES(fmt.Sprintf("Type: %d, message: %s\n", msgType, string(msg)))
+			if frame.run != nil {
+				frame.run()
+				continue
+			}
+			websocket.handleMessage(frame.connection, frame.msgType, frame.msg)
+		case <-websocket.closing:
+			return
+		}
+	}
+}
 
-			websocket.RecordLastHeartbeat()
+func (websocket *Websocket) handleMessage(connection *websocketConnection, msgType int, msg []byte) {
+	handlers := websocket.getHandlers()
 
-			if websocket.privateMessageValidator != nil {
-				isPrivate, Id := websocket.privateMessageValidator(msg)
-				if isPrivate {
-					LOG_WS_VERBOSE("[VERBOSE] Private Message detected:", string(msg))
+	if handlers.privateMessageValidator != nil {
+		isPrivate, Id := handlers.privateMessageValidator(msg)
+		if isPrivate {
+			LOG_WS_VERBOSE("[VERBOSE] Private Message detected:", string(msg))
 
-					websocket.pendingRequests[Id] <- msg
-					if websocket.OnPrivateMessage != nil {
-						websocket.OnPrivateMessage(msg)
-					}
-					delete(websocket.pendingRequests, Id)
-					continue
-				}
+			websocket.resolveRequest(Id, msg)
+			if handlers.onPrivateMessage != nil {
+				handlers.onPrivateMessage(msg)
 			}
+			return
+		}
+	}
 
-			if websocket.IsCombined {
-				var tempData CombinedStream_MSG
-				err := json.Unmarshal(msg, &tempData)
-				if err != nil {
-					LOG_WS_ERRORS("ERR PARSING COMBINED MESSAGE!!!")
-					panic(fmt.Sprintln(LocalError(PARSING_ERR, err.Error()).Error()))
-				}
-				msg = tempData.Data
+	// Its events were delivered by the replacement, see websocketRotation
+	if connection.retired.Load() {
+		return
+	}
+
+	var stream string
+	if websocket.IsCombined {
+		var tempData CombinedStream_MSG
+		err := json.Unmarshal(msg, &tempData)
+		if err != nil {
+			websocket.decodeError("", msg, err)
+			return
+		}
+		stream = tempData.Stream
+		msg = tempData.Data
+	} else {
+		// Raw messages don't carry their stream, it's only known while the socket has a single one
+		stream = websocket.singleStream()
+	}
+
+	rotation := websocket.rotation.Load()
+	if rotation == nil {
+		websocket.deliver(handlers, msgType, stream, msg)
+		return
+	}
+
+	for _, frame := range rotation.accept(connection == rotation.replacement, msgType, stream, msg) {
+		websocket.deliver(handlers, frame.msgType, frame.stream, frame.data)
+	}
+	if rotation.isDone() {
+		websocket.rotation.CompareAndSwap(rotation, nil)
+	}
+}
+
+func (websocket *Websocket) deliver(handlers websocketHandlers, msgType int, stream string, msg []byte) {
+	if handlers.streamDecoder != nil {
+		handlers.streamDecoder(stream, msg)
+	}
+
+	if handlers.onStreamMessage != nil {
+		handlers.onStreamMessage(stream, msg)
+	}
+
+	if handlers.onMessage != nil {
+		handlers.onMessage(msgType, msg)
+	}
+}
+
+// # A message that could not be decoded, or messages missed while reconnecting that could not be backfilled
+//
+// Received by the socket's error listener, the socket keeps running.
+type WebsocketDecodeError struct {
+	// A PARSING_ERR, or the error of the backfill's requests
+	Err *Error
+	// The stream the message was received on, empty if unknown (an undecodable combined message, or a raw socket with several streams)
+	//
+	// The symbol (and interval) whose missed messages could not be fetched for a backfill error
+	Stream string
+	// The message as received, empty for a backfill error
+	Raw []byte
+
+	// Set if the messages missed on "Stream" could not all be fetched after a reconnection, see SetBackfill()
+	IsBackfill bool
+}
+
+func (decodeErr *WebsocketDecodeError) Error() string {
+	if decodeErr.IsBackfill {
+		return fmt.Sprintf("stream '%s': could not backfill the missed messages: %s", decodeErr.Stream, decodeErr.Err.Error())
+	}
+	return fmt.Sprintf("stream '%s': %s, message: %s", decodeErr.Stream, decodeErr.Err.Error(), string(decodeErr.Raw))
+}
+
+// Reports the missed messages of "stream" that could not be fetched to the error listener, logs it if there is none
+func (websocket *Websocket) backfillError(stream string, err *Error) {
+	backfillErr := &WebsocketDecodeError{
+		Err:        err,
+		Stream:     stream,
+		IsBackfill: true,
+	}
+
+	onError := websocket.getHandlers().onError
+	if onError == nil {
+		LOG_WS_ERRORS("[BACKFILL]", backfillErr.Error())
+		return
+	}
+	onError(backfillErr)
+}
+
+// Reports a message that could not be decoded to the error listener, logs it if there is none
+func (websocket *Websocket) decodeError(stream string, msg []byte, err error) {
+	decodeErr := &WebsocketDecodeError{
+		Err:    LocalError(PARSING_ERR, err.Error()),
+		Stream: stream,
+		Raw:    msg,
+	}
+
+	onError := websocket.getHandlers().onError
+	if onError == nil {
+		LOG_WS_ERRORS("[WEBSOCKET] Could not decode a message:", decodeErr.Error())
+		return
+	}
+	onError(decodeErr)
+}
+
+func (websocket *Websocket) singleStream() string {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	if len(websocket.streams) != 1 {
+		return ""
+	}
+	return websocket.streams[0]
+}
+
+func (websocket *Websocket) heartbeatLoop(connection *websocketConnection) {
+	ticker := time.NewTicker(time.Duration(Constants.Websocket.HEARTBEAT_CHECK_INTERVAL_SEC) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-connection.done:
+			LOG_WS_VERBOSE_FULL("[HEARTBEAT] Connection is closed, stopping checks.")
+			return
+		case <-ticker.C:
+		}
+
+		elapsed := time.Now().Unix() - websocket.LastHeartbeat()
+
+		// Check if the last heartbeat is older than the close interval
+		if elapsed >= Constants.Websocket.HEARTBEAT_CLOSE_ON_NO_HEARTBEAT_SEC {
+			LOG_WS_VERBOSE("[HEARTBEAT] Reconnecting to websocket...")
+
+			websocket.connectionLost(connection, -1, fmt.Sprintf("No heartbeat for %d seconds", elapsed))
+			return
+		}
+
+		// Check if the last heartbeat is older than the heartbeat check interval
+		if elapsed >= Constants.Websocket.HEARTBEAT_CHECK_INTERVAL_SEC {
+			if connection.tryWrite(ws.PingMessage, nil) {
+				LOG_WS_VERBOSE_FULL("[HEARTBEAT] Ping queued.")
+			} else {
+				LOG_WS_ERRORS("[HEARTBEAT] Error sending ping: the write queue is full")
 			}
+		}
 
-			if websocket.OnMessage != nil {
-				websocket.OnMessage(msgType, msg)
+		// Retried on the next tick if it fails
+		if time.Since(connection.createdAt) >= time.Duration(Constants.Websocket.EXPECTED_DISCONNECTION_TIME_SEC)*time.Second && connection.rotating.CompareAndSwap(false, true) {
+			websocket.goroutine(func() {
+				if !websocket.rotate(connection) {
+					connection.rotating.Store(false)
+				}
+			})
+		}
+	}
+}
+
+// # Replaces "old" by a new connection with the same streams, see websocketRotation
+//
+// Returns false if the replacement could not be opened, "old" is kept in that case.
+func (websocket *Websocket) rotate(old *websocketConnection) bool {
+	streams := websocket.Streams()
+
+	// The current connection still works, rotating can wait for the next heartbeat tick
+	if !websocketConnectionBudget.tryReserve() {
+		LOG_WS_VERBOSE("[*Websocket.rotate()] Connection attempts budget exhausted, retrying later")
+		return false
+	}
+
+	replacement, err := websocket.dial()
+	if err != nil {
+		LOG_WS_ERRORS("[*Websocket.rotate()] There was an error opening the replacement connection:", err)
+		return false
+	}
+
+	websocket.mu.Lock()
+	if websocket.state != Constants.WebsocketStates.OPEN || websocket.connection != old {
+		websocket.mu.Unlock()
+		replacement.close()
+		return true
+	}
+	rotation := &websocketRotation{
+		replacement: replacement,
+		streams:     make(map[string]*rotationStream),
+		previous:    websocket.rotation.Load(),
+	}
+	websocket.rotation.Store(rotation)
+	websocket.mu.Unlock()
+
+	LOG_WS_VERBOSE("[*Websocket.rotate()] Replacement connection opened, overlapping both connections...")
+	websocket.start(replacement)
+
+	overlap := time.Duration(Constants.Websocket.ROTATION_OVERLAP_SEC) * time.Second
+	timer := time.NewTimer(overlap)
+	defer timer.Stop()
+
+overlapping:
+	for overlaps := 1; ; overlaps++ {
+		select {
+		case <-timer.C:
+			if rotation.canSwitch() {
+				break overlapping
 			}
+			if overlaps >= Constants.Websocket.ROTATION_MAX_OVERLAPS {
+				LOG_WS_ERRORS("[*Websocket.rotate()] The old connection is still behind the replacement, switching anyway")
+				break overlapping
+			}
+			timer.Reset(overlap)
+		case <-old.done:
+			// Dropped during the overlap, 'connectionLost' already switched to the replacement
+			break overlapping
+		case <-replacement.done:
+			break overlapping
 		}
-	}()
+	}
 
-	go func() {
-		ticker := time.NewTicker(time.Duration(Constants.Websocket.HEARTBEAT_CHECK_INTERVAL_SEC) * time.Second)
-		defer ticker.Stop()
+	websocket.mu.Lock()
+	if websocket.state == Constants.WebsocketStates.OPEN && websocket.connection == old && !replacement.isClosed() {
+		websocket.connection = replacement
+		// Retired first, none of its messages may be delivered once the rotation stops filtering
+		old.retired.Store(true)
+		rotation.setSwitched()
+	}
+	switched := websocket.connection == replacement
+	websocket.mu.Unlock()
+
+	if !switched {
+		replacement.retired.Store(true)
+		websocket.rotation.CompareAndSwap(rotation, nil)
+		replacement.close()
+		LOG_WS_ERRORS("[*Websocket.rotate()] The replacement connection was lost, keeping the current one")
+		return false
+	}
+
+	// The replacement's events are filtered until it caught up with the old connection, see websocketRotation
+	old.retired.Store(true)
+	old.close()
+	websocket.releaseHeld(rotation)
+
+	websocket.resyncStreams(streams)
 
-		for {
+	LOG_WS_VERBOSE("[*Websocket.rotate()] Successfully rotated the connection.")
+	return true
+}
 
-			<-ticker.C // Wait the appropriate amount of time
-			if websocket.closed {
-				LOG_WS_VERBOSE("[HEARTBEAT] Websocket is closed, skipping check.")
+// Has the dispatch goroutine deliver the replacement's held events once "rotation" switched, the old connection won't deliver them
+func (websocket *Websocket) releaseHeld(rotation *websocketRotation) {
+	select {
+	case websocket.frames <- websocketFrame{switched: rotation}:
+	case <-websocket.closing:
+	}
+}
+
+// Has the dispatch goroutine call "f" in between the messages, returns false if the socket was closed first
+//
+// Must not be called from the dispatch goroutine
+func (websocket *Websocket) runOnDispatch(f func()) bool {
+	select {
+	case websocket.frames <- websocketFrame{run: f}:
+		return true
+	case <-websocket.closing:
+		return false
+	}
+}
+
+// Applies the (un)subscriptions made on the old connection while the replacement was dialed with "dialedStreams"
+func (websocket *Websocket) resyncStreams(dialedStreams []string) {
+	current := websocket.Streams()
+
+	var subscribe, unsubscribe []string
+	for _, stream := range current {
+		if !slices.Contains(dialedStreams, stream) {
+			subscribe = append(subscribe, stream)
+		}
+	}
+	for _, stream := range dialedStreams {
+		if !slices.Contains(current, stream) {
+			unsubscribe = append(unsubscribe, stream)
+		}
+	}
+
+	websocket.subscriptionsMu.Lock()
+	defer websocket.subscriptionsMu.Unlock()
+
+	if len(subscribe) != 0 {
+		_, _, err := websocket.streamRequest("SUBSCRIBE", subscribe)
+		if err != nil {
+			LOG_WS_ERRORS("[*Websocket.rotate()] There was an error resubscribing to", subscribe, err.Error())
+		}
+	}
+	if len(unsubscribe) != 0 {
+		_, _, err := websocket.streamRequest("UNSUBSCRIBE", unsubscribe)
+		if err != nil {
+			LOG_WS_ERRORS("[*Websocket.rotate()] There was an error unsubscribing from", unsubscribe, err.Error())
+		}
+	}
+}
+
+func (websocket *Websocket) handlePing(connection *websocketConnection, appData string) {
+	LOG_WS_VERBOSE_FULL("Received a ping:", appData)
+
+	if !connection.tryWrite(ws.PongMessage, []byte(appData)) {
+		LOG_WS_ERRORS("Error sending Pong: the write queue is full")
+	}
+
+	if onPing := websocket.getHandlers().onPing; onPing != nil {
+		onPing(appData)
+	}
+
+	websocket.RecordLastHeartbeat()
+}
+
+func (websocket *Websocket) handlePong(appData string) {
+	LOG_WS_VERBOSE_FULL("Received a pong:", appData)
+
+	if onPong := websocket.getHandlers().onPong; onPong != nil {
+		onPong(appData)
+	}
+
+	websocket.RecordLastHeartbeat()
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// Called by the connection's goroutines when it stops working
+//
+// Reconnects if it is still the socket's current connection and the socket is open, otherwise only tears it down.
+func (websocket *Websocket) connectionLost(connection *websocketConnection, code int, text string) {
+	websocket.mu.Lock()
+	if websocket.connection != connection || websocket.state != Constants.WebsocketStates.OPEN {
+		websocket.mu.Unlock()
+		connection.close()
+		return
+	}
+
+	// Dropped while rotating, the replacement is already receiving the same streams
+	if rotation := websocket.rotation.Load(); rotation != nil && !rotation.hasSwitched() && !rotation.replacement.isClosed() {
+		websocket.connection = rotation.replacement
+		connection.retired.Store(true)
+		rotation.setSwitched()
+		websocket.mu.Unlock()
+
+		LOG_WS_VERBOSE("[*Websocket.connectionLost()] Lost the connection being rotated, switched to its replacement")
+		connection.close()
+		websocket.releaseHeld(rotation)
+		return
+	}
+
+	websocket.state = Constants.WebsocketStates.RECONNECTING
+	onDisconnect := websocket.handlers.onDisconnect
+	websocket.mu.Unlock()
+
+	LOG_WS_VERBOSE("[*Websocket.connectionLost()] code", code, "text", text)
+	connection.close()
+
+	if onDisconnect != nil {
+		onDisconnect(code, text)
+	}
+
+	websocket.reconnect()
+}
 
+// # Forcefully reconnects the socket
+//
+// Does nothing if the socket is closed or already reconnecting.
+func (websocket *Websocket) Reconnect() {
+	websocket.mu.Lock()
+	if websocket.state != Constants.WebsocketStates.OPEN {
+		LOG_WS_VERBOSE("[*Websocket.RECONNECT()] Skipping reconnection, state:", websocket.state)
+		websocket.mu.Unlock()
+		return
+	}
+	websocket.state = Constants.WebsocketStates.RECONNECTING
+	connection := websocket.connection
+	websocket.mu.Unlock()
+
+	connection.close()
+
+	websocket.reconnect()
+}
+
+// Dials until a new connection is open, the state must already be RECONNECTING
+//
+// Failed attempts are delayed by the reconnection policy, the socket is closed once its MaxAttempts are used up.
+func (websocket *Websocket) reconnect() {
+	LOG_WS_VERBOSE("[*Websocket.RECONNECT()] Reconnecting socket...")
+
+	handlers := websocket.getHandlers()
+	if handlers.onReconnecting != nil {
+		handlers.onReconnecting()
+	}
+
+	if handlers.beforeReconnect != nil {
+		handlers.beforeReconnect()
+	}
+
+	policy := websocket.getReconnectPolicy()
+
+	var connection *websocketConnection
+	for attempt := 1; ; attempt++ {
+		if !websocketConnectionBudget.wait(websocket.closing) {
+			return
+		}
+
+		var err error
+		connection, err = websocket.dial()
+		if err != nil {
+			LOG_WS_ERRORS("There was an error reconnecting socket, attempt", attempt, ":", err)
+
+			if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+				websocket.giveUp(fmt.Sprintf("gave up reconnecting after %d attempts: %s", attempt, err.Error()))
 				return
 			}
 
-			if websocket.isReconnecting {
-				continue
+			select {
+			case <-time.After(policy.delay(attempt)):
+			case <-websocket.closing:
+				return
 			}
+			continue
+		}
 
-			currentTime := time.Now().Unix()
+		websocket.mu.Lock()
+		if websocket.state == Constants.WebsocketStates.CLOSED {
+			websocket.mu.Unlock()
+			connection.close()
+			return
+		}
+		websocket.connection = connection
+		websocket.state = Constants.WebsocketStates.OPEN
+		// A new connection has no events in common with the rotated ones
+		websocket.rotation.Store(nil)
+		websocket.mu.Unlock()
+		break
+	}
 
-			elapsed := currentTime - websocket.Last_Heartbeat_Timestamp
+	websocket.RecordLastHeartbeat()
+	websocket.start(connection)
 
-			// Check if the last heartbeat is older than the close interval
-			if elapsed >= Constants.Websocket.HEARTBEAT_CLOSE_ON_NO_HEARTBEAT_SEC {
-				LOG_WS_VERBOSE("[HEARTBEAT] Reconnecting to websocket...")
+	handlers = websocket.getHandlers()
+	if handlers.onReconnect != nil {
+		handlers.onReconnect()
+	}
 
-				websocket.Reconnect()
-				return
-			}
+	if handlers.afterReconnect != nil {
+		handlers.afterReconnect()
+	}
 
-			// Check if the last heartbeat is older than the heartbeat check interval
-			if elapsed >= Constants.Websocket.HEARTBEAT_CHECK_INTERVAL_SEC {
-				err := websocket.Conn.WriteMessage(ws.PingMessage, nil)
-				if err != nil {
-					LOG_WS_ERRORS("[HEARTBEAT] Error sending ping:", err)
+	LOG_WS_VERBOSE("[*Websocket.RECONNECT()] Successfully reconnected the socket.")
+}
 
-				} else {
-					LOG_WS_VERBOSE_FULL("[HEARTBEAT] Ping sent.")
-				}
-			}
+// This terminates the socket indefinitely
+func (websocket *Websocket) Close() error {
+	websocket.mu.Lock()
+	if websocket.state == Constants.WebsocketStates.CLOSED {
+		websocket.mu.Unlock()
+		return fmt.Errorf("[LIB] Socket was already closed before closing")
+	}
+	websocket.state = Constants.WebsocketStates.CLOSED
+	connection := websocket.connection
+	onClose := websocket.handlers.onClose
+	websocket.mu.Unlock()
+
+	websocket.closingOnce.Do(func() { close(websocket.closing) })
+
+	LOG_WS_VERBOSE("[*Websocket.CLOSE()] Closing socket indefinitely")
+
+	// Best effort, the connection is torn down either way
+	err := connection.write(ws.CloseMessage, ws.FormatCloseMessage(ws.CloseNormalClosure, ""))
+	if err != nil && err != errWebsocketConnectionClosed {
+		LOG_WS_ERRORS("[*Websocket.CLOSE()] There was an error sending the close frame:", err)
+	}
+	connection.close()
+
+	if onClose != nil {
+		onClose(-1, "")
+	}
+
+	return nil
+}
+
+// Closes a socket that could not reconnect, "text" is passed to the close listener
+func (websocket *Websocket) giveUp(text string) {
+	websocket.mu.Lock()
+	if websocket.state == Constants.WebsocketStates.CLOSED {
+		websocket.mu.Unlock()
+		return
+	}
+	websocket.state = Constants.WebsocketStates.CLOSED
+	onClose := websocket.handlers.onClose
+	websocket.mu.Unlock()
+
+	websocket.closingOnce.Do(func() { close(websocket.closing) })
+
+	LOG_WS_ERRORS("[*Websocket.RECONNECT()] Closing socket,", text)
+
+	if onClose != nil {
+		onClose(-1, text)
+	}
+}
+
+// # Reconnection policy
+//
+// Failed reconnection attempts are delayed exponentially, from MinDelay_ms up to MaxDelay_ms,
+// each delay is randomized between half and all of its value so that sockets dropped together don't reconnect together.
+//
+// Zero values use the Constants.Websocket.RECONNECT_* defaults.
+type Websocket_ReconnectPolicy struct {
+	// Failed attempts in a row before the socket is closed (OnClose is called), 0 retries forever
+	MaxAttempts int
+	MinDelay_ms int64
+	MaxDelay_ms int64
+}
+
+// Delay after the "attempt"th failed attempt
+func (policy Websocket_ReconnectPolicy) delay(attempt int) time.Duration {
+	delay := policy.MinDelay_ms
+	for i := 1; i < attempt && delay < policy.MaxDelay_ms; i++ {
+		delay *= 2
+	}
+	delay = min(delay, policy.MaxDelay_ms)
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return time.Duration(half+rand.Int63n(delay-half+1)) * time.Millisecond
+}
+
+// Applies to the next reconnection
+func (websocket *Websocket) SetReconnectPolicy(policy Websocket_ReconnectPolicy) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+	websocket.reconnectPolicy = policy
+}
+
+func (websocket *Websocket) getReconnectPolicy() Websocket_ReconnectPolicy {
+	websocket.mu.Lock()
+	policy := websocket.reconnectPolicy
+	websocket.mu.Unlock()
+
+	if policy.MaxAttempts == 0 {
+		policy.MaxAttempts = Constants.Websocket.RECONNECT_MAX_ATTEMPTS
+	}
+	if policy.MinDelay_ms <= 0 {
+		policy.MinDelay_ms = Constants.Websocket.RECONNECT_MIN_DELAY_MS
+	}
+	if policy.MaxDelay_ms <= 0 {
+		policy.MaxDelay_ms = Constants.Websocket.RECONNECT_MAX_DELAY_MS
+	}
+	policy.MaxDelay_ms = max(policy.MaxDelay_ms, policy.MinDelay_ms)
+
+	return policy
+}
+
+// # Connection attempts budget
+//
+// Binance allows CONNECTION_ATTEMPTS_PER_5MINS connection attempts per IP,
+// every socket of the process dials through this budget (creations, reconnections and rotations).
+type connectionBudget struct {
+	mu       sync.Mutex
+	attempts []time.Time
+}
+
+var websocketConnectionBudget = &connectionBudget{}
+
+const connectionBudget_window = 5 * time.Minute
+
+// Reserves an attempt, or returns how long to wait until one is available
+func (budget *connectionBudget) reserve() time.Duration {
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+
+	now := time.Now()
+	expired := 0
+	for expired < len(budget.attempts) && now.Sub(budget.attempts[expired]) >= connectionBudget_window {
+		expired++
+	}
+	budget.attempts = budget.attempts[expired:]
+
+	limit := int(Constants.Websocket.CONNECTION_ATTEMPTS_PER_5MINS)
+	if limit > 0 && len(budget.attempts) >= limit {
+		return connectionBudget_window - now.Sub(budget.attempts[len(budget.attempts)-limit])
+	}
+
+	budget.attempts = append(budget.attempts, now)
+	return 0
+}
+
+func (budget *connectionBudget) tryReserve() bool {
+	return budget.reserve() == 0
+}
+
+// Waits for an attempt to be available, returns false if "cancel" is closed first
+func (budget *connectionBudget) wait(cancel <-chan struct{}) bool {
+	for {
+		delay := budget.reserve()
+		if delay <= 0 {
+			return true
 		}
-	}()
+
+		LOG_WS_VERBOSE("[WEBSOCKET] Connection attempts budget exhausted, waiting", delay)
+		select {
+		case <-time.After(delay):
+		case <-cancel:
+			return false
+		}
+	}
 }
 
+/////////////////////////////////////////////////////////////////////////////////
+
 func (websocket *Websocket) SendRequest_sync(req map[string]interface{}, timeout_sec ...int) (data []byte, hasTimedOut bool, WS_send_err *Error) {
-	respChan := make(chan []byte, 1)
+	Id := req["id"].(string)
 
 	LOG_WS_VERBOSE("[VERBOSE] Sending request:", req)
 
-	websocket.pendingRequests[req["id"].(string)] = respChan
+	payload, err := jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(req)
+	if err != nil {
+		return nil, false, LocalError(WS_SEND_MESSAGE_ERR, err.Error())
+	}
+
+	connection, state := websocket.currentConnection()
+	if connection == nil {
+		return nil, false, LocalError(WS_SEND_MESSAGE_ERR, fmt.Sprintf("The socket is not open, its state is '%s'", state))
+	}
+
+	respChan := make(chan []byte, 1)
+
+	websocket.pendingMu.Lock()
+	websocket.pendingRequests[Id] = respChan
+	websocket.pendingMu.Unlock()
+
+	defer func() {
+		websocket.pendingMu.Lock()
+		delete(websocket.pendingRequests, Id)
+		websocket.pendingMu.Unlock()
+	}()
 
 	// Send the message including the ID
-	err := websocket.Conn.WriteJSON(req)
+	err = connection.write(ws.TextMessage, payload)
 	if err != nil {
 		return nil, false, LocalError(WS_SEND_MESSAGE_ERR, err.Error())
 	}
@@ -222,143 +1362,230 @@ func (websocket *Websocket) SendRequest_sync(req map[string]interface{}, timeout
 	select {
 	case resp := <-respChan:
 		return resp, false, nil
+	case <-connection.done:
+		return nil, false, LocalError(WS_SEND_MESSAGE_ERR, "The connection was lost before a response was received")
 	case <-timer:
-		delete(websocket.pendingRequests, req["id"].(string))
 		return nil, true, LocalError(REQUEST_TIMEOUT_ERR, fmt.Sprintf("The request has timed out after %d seconds...", timeout))
 	}
 }
 
-func CreateQueryStringWS(streams []string, isCombined bool) string {
-	streamsStr := ""
-	if isCombined {
-		streamsStr += "/stream?streams=" + strings.Join(streams, "/")
-	} else {
-		streamsStr += "/ws/" + streams[0]
+// Hands a response to its pending request, if it is still waiting
+func (websocket *Websocket) resolveRequest(Id string, msg []byte) {
+	websocket.pendingMu.Lock()
+	respChan, exists := websocket.pendingRequests[Id]
+	delete(websocket.pendingRequests, Id)
+	websocket.pendingMu.Unlock()
+
+	if exists {
+		respChan <- msg
 	}
+}
 
-	return streamsStr
+// Returns nil and the state if the socket is not open
+func (websocket *Websocket) currentConnection() (connection *websocketConnection, state string) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	if websocket.state != Constants.WebsocketStates.OPEN {
+		return nil, websocket.state
+	}
+	return websocket.connection, websocket.state
 }
 
-func (websocket *Websocket) Reconnect() {
-	websocket.isReconnecting = true
-	websocket.subsocket_id++
+/////////////////////////////////////////////////////////////////////////////////
 
-	LOG_WS_VERBOSE("[*Websocket.RECONNECT()] Reconnecting socket, closed:", websocket.closed)
-	if !websocket.closed {
-		err := websocket.silentCloseSocket()
-		if err != nil {
-			LOG_WS_ERRORS("[*Websocket.RECONNECT()] [SilentClose] There was an error silent closing the socket.")
+// Returns one of Constants.WebsocketStates
+func (websocket *Websocket) State() string {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	return websocket.state
+}
+
+// Returns a copy of the streams the socket connects to
+func (websocket *Websocket) Streams() []string {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	return append([]string(nil), websocket.streams...)
+}
+
+func (websocket *Websocket) setStreams(streams []string) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	websocket.streams = append([]string(nil), streams...)
+}
+
+func (websocket *Websocket) addStreams(streams ...string) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	for _, stream := range streams {
+		if !slices.Contains(websocket.streams, stream) {
+			websocket.streams = append(websocket.streams, stream)
 		}
 	}
+}
 
-	if websocket.OnReconnecting != nil {
-		websocket.OnReconnecting()
-	}
+func (websocket *Websocket) removeStreams(streams ...string) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
 
-	for {
-		queryStr := CreateQueryStringWS(websocket.Streams, websocket.IsCombined)
-		conn, _, err := ws.DefaultDialer.Dial(websocket.BaseURL+queryStr, nil)
-		if err != nil {
-			LOG_WS_ERRORS("There was an error reconnecting socket:", err)
+	streamMap := make(map[string]bool)
+	for _, stream := range streams {
+		streamMap[stream] = true
+	}
 
-			time.Sleep(500 * time.Millisecond)
-			continue // Retry until successful
+	var updatedStreams []string
+	for _, existingStream := range websocket.streams {
+		if !streamMap[existingStream] {
+			updatedStreams = append(updatedStreams, existingStream)
 		}
-
-		// Assign the newly created socket and break the loop
-		websocket.Conn = conn
-		websocket.closed = false
-		websocket.RecordLastHeartbeat()
-		break
 	}
+	websocket.streams = updatedStreams
+}
 
-	setUpSocket(websocket, websocket.Conn)
-	websocket.isReconnecting = false
+// Unix time in seconds of the last message, ping or pong received
+func (websocket *Websocket) LastHeartbeat() int64 {
+	return websocket.lastHeartbeat.Load()
+}
+
+func (websocket *Websocket) RecordLastHeartbeat() {
+	websocket.lastHeartbeat.Store(time.Now().Unix())
+}
 
-	if websocket.OnReconnect != nil {
-		websocket.OnReconnect()
+// Deprecated: Use LastHeartbeat(), this replaces the removed "Last_Heartbeat_Timestamp" field.
+func (websocket *Websocket) GetLast_Heartbeat_Timestamp() int64 {
+	return websocket.LastHeartbeat()
+}
+
+// # Returns the current connection, nil while reconnecting or once closed
+//
+// Deprecated: This replaces the removed "Conn" field, only to read the connection's properties.
+// Writing to it or setting its handlers breaks the socket, the connection only supports one writer, use SendRequest_sync() instead.
+func (websocket *Websocket) Conn() *ws.Conn {
+	connection, _ := websocket.currentConnection()
+	if connection == nil {
+		return nil
 	}
+	return connection.conn
+}
 
-	LOG_WS_VERBOSE("[*Websocket.RECONNECT()] Successfully reconnected the socket.")
+/////////////////////////////////////////////////////////////////////////////////
+
+func (websocket *Websocket) getHandlers() websocketHandlers {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	return websocket.handlers
 }
 
-// This terminates the socket indefinitely
-func (websocket *Websocket) Close() error {
-	websocket.reconnect = false
+func (websocket *Websocket) SetMessageListener(f func(messageType int, msg []byte)) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
 
-	LOG_WS_VERBOSE("[*Websocket.CLOSE()] Forcefully closing socket indefinitely, closed:", websocket.closed)
+	websocket.handlers.onMessage = f
+}
 
-	if !websocket.closed {
-		err := websocket.silentCloseSocket()
-		if err != nil {
-			return err
-		}
-		websocket.CloseHandler(-1, "")
-	}
+// This is called when a response for a request has been received
+func (websocket *Websocket) SetPrivateMessageListener(f func(msg []byte)) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
 
-	return fmt.Errorf("[LIB] Socket was already closed before closing")
+	websocket.handlers.onPrivateMessage = f
 }
 
-func (websocket *Websocket) silentCloseSocket() error {
-	LOG_WS_VERBOSE("[*Websocket.silentClose()] Forcefully closing socket, closed:", websocket.closed)
-	err := websocket.Conn.Close()
-	if err != nil {
-		fmt.Println("[*Websocket.silentClose()] There was an error closing the socket:", err)
-		return err
-	}
+func (websocket *Websocket) SetPingListener(f func(appData string)) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
 
-	return nil
+	websocket.handlers.onPing = f
 }
 
-func (websocket *Websocket) CloseHandler(code int, text string) error {
-	LOG_WS_VERBOSE("[*Websocket.CloseHandler()] code", code, "text", text, "is closed", websocket.closed)
-	websocket.closed = true
+func (websocket *Websocket) SetPongListener(f func(appData string)) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
 
-	if websocket.reconnect {
-		if websocket.OnDisconnect != nil {
-			websocket.OnDisconnect(code, text)
-		}
-		websocket.Reconnect()
-	} else {
-		if websocket.OnClose != nil {
-			websocket.OnClose(code, text)
-		}
-	}
+	websocket.handlers.onPong = f
+}
 
-	return nil
+// This is called when the connection was lost, right before the 'ReconnectingListener'
+func (websocket *Websocket) SetDisconnectListener(f func(code int, text string)) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	websocket.handlers.onDisconnect = f
 }
 
-func (websocket *Websocket) PingHandler(appData string) error {
-	LOG_WS_VERBOSE_FULL("Received a ping:", appData)
+func (websocket *Websocket) SetReconnectingListener(f func()) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
 
-	err := websocket.Conn.WriteMessage(ws.PongMessage, []byte(appData))
-	if err != nil {
-		LOG_WS_ERRORS("Error sending Pong:", err)
+	websocket.handlers.onReconnecting = f
+}
 
-		return err
-	}
-	if websocket.OnPing != nil {
-		websocket.OnPing(appData)
-	}
+func (websocket *Websocket) SetReconnectListener(f func()) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
 
-	websocket.RecordLastHeartbeat()
+	websocket.handlers.onReconnect = f
+}
 
-	return nil
+// This is called when the socket is closed indefinitely with 'Close()'
+func (websocket *Websocket) SetCloseListener(f func(code int, text string)) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	websocket.handlers.onClose = f
 }
 
-func (websocket *Websocket) PongHandler(appData string) error {
+func (websocket *Websocket) setPrivateMessageValidator(f func(msg []byte) (isPrivate bool, Id string)) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
 
-	LOG_WS_VERBOSE_FULL("Received a pong:", appData)
+	websocket.handlers.privateMessageValidator = f
+}
 
-	if websocket.OnPong != nil {
-		websocket.OnPong(appData)
-	}
+// # Receives every stream message with its stream's name
+//
+// Combined messages are unwrapped, raw messages have their stream's name while the socket has a single stream (empty otherwise).
+//
+// Called before the message listener.
+func (websocket *Websocket) SetStreamMessageListener(f func(stream string, msg []byte)) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
 
-	websocket.RecordLastHeartbeat()
+	websocket.handlers.onStreamMessage = f
+}
 
-	return nil
+// # Receives the messages that could not be decoded
+//
+// Without an error listener, they are logged.
+func (websocket *Websocket) SetErrorListener(f func(err *WebsocketDecodeError)) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	websocket.handlers.onError = f
 }
 
-func (websocket *Websocket) RecordLastHeartbeat() {
-	websocket.Last_Heartbeat_Timestamp = time.Now().Unix()
+func (websocket *Websocket) setStreamDecoder(f func(stream string, msg []byte)) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	websocket.handlers.streamDecoder = f
+}
+
+func (websocket *Websocket) setBeforeReconnect(f func()) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	websocket.handlers.beforeReconnect = f
+}
+
+func (websocket *Websocket) setAfterReconnect(f func()) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	websocket.handlers.afterReconnect = f
 }
@@ -0,0 +1,198 @@
+package Binance
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type streamRequest_Response struct {
+	Id     string                `json:"id"`
+	Result []string              `json:"result"`
+	Error  *BinanceErrorResponse `json:"error"`
+}
+
+// Sends a SUBSCRIBE, UNSUBSCRIBE or LIST_SUBSCRIPTIONS request, the same on every market
+//
+// A response with an error payload is returned as a non-local error.
+func (websocket *Websocket) streamRequest(method string, streams []string, timeout_sec ...int) (response *streamRequest_Response, hasTimedOut bool, err *Error) {
+	requestObj := map[string]interface{}{
+		"id":     uuid.New().String(),
+		"method": method,
+	}
+	if streams != nil {
+		requestObj["params"] = streams
+	}
+
+	data, timedOut, err := websocket.SendRequest_sync(requestObj, timeout_sec...)
+	if err != nil {
+		return nil, timedOut, err
+	}
+
+	response = &streamRequest_Response{}
+	unmarshallErr := json.Unmarshal(data, response)
+	if unmarshallErr != nil {
+		return nil, false, LocalError(ERROR_PROCESSING_ERR, unmarshallErr.Error())
+	}
+	if response.Error != nil {
+		return response, false, newError(false, 0, response.Error.Code, response.Error.Msg)
+	}
+
+	return response, false, nil
+}
+
+// # Sends a SUBSCRIBE or UNSUBSCRIBE request, the socket's streams are only updated once Binance accepts it
+func (websocket *Websocket) updateSubscriptions(method string, streams []string, timeout_sec ...int) (Id string, hasTimedOut bool, err *Error) {
+	websocket.subscriptionsMu.Lock()
+	defer websocket.subscriptionsMu.Unlock()
+
+	response, timedOut, err := websocket.streamRequest(method, streams, timeout_sec...)
+	if err != nil {
+		return "", timedOut, err
+	}
+
+	switch method {
+	case "SUBSCRIBE":
+		websocket.addStreams(streams...)
+	case "UNSUBSCRIBE":
+		websocket.removeStreams(streams...)
+	}
+
+	return response.Id, false, nil
+}
+
+/////////////////////////////////////////////////////////////////////////////////
+
+// # Difference between the socket's streams and the ones Binance has subscribed
+type SubscriptionDrift struct {
+	// Streams of the socket that Binance had not subscribed
+	Missing []string
+	// Streams subscribed by Binance that the socket doesn't have, left as they are
+	Unexpected []string
+
+	// Whether the missing streams were subscribed again
+	Resubscribed bool
+	// Why they could not be, nil if they were
+	Err *Error
+}
+
+// # Compares the socket's streams with Binance's LIST_SUBSCRIPTIONS
+//
+// Missing streams are subscribed again and the drift listener is called, returns nil if there was no drift.
+func (websocket *Websocket) Reconcile(timeout_sec ...int) (*SubscriptionDrift, *Error) {
+	drift, err := websocket.reconcile(timeout_sec...)
+	if err != nil || drift == nil {
+		return drift, err
+	}
+
+	LOG_WS_ERRORS("[*Websocket.Reconcile()] Subscriptions drifted, missing:", drift.Missing, "unexpected:", drift.Unexpected)
+
+	if onDrift := websocket.getHandlers().onDrift; onDrift != nil {
+		onDrift(drift)
+	}
+
+	return drift, nil
+}
+
+func (websocket *Websocket) reconcile(timeout_sec ...int) (*SubscriptionDrift, *Error) {
+	websocket.subscriptionsMu.Lock()
+	defer websocket.subscriptionsMu.Unlock()
+
+	response, _, err := websocket.streamRequest("LIST_SUBSCRIPTIONS", nil, timeout_sec...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compared case-insensitively, i.e: "btcusdt@aggTrade"
+	subscribed := make(map[string]bool, len(response.Result))
+	for _, stream := range response.Result {
+		subscribed[strings.ToLower(stream)] = true
+	}
+	local := make(map[string]bool)
+
+	drift := &SubscriptionDrift{}
+	for _, stream := range websocket.Streams() {
+		local[strings.ToLower(stream)] = true
+		if !subscribed[strings.ToLower(stream)] {
+			drift.Missing = append(drift.Missing, stream)
+		}
+	}
+	for _, stream := range response.Result {
+		if !local[strings.ToLower(stream)] {
+			drift.Unexpected = append(drift.Unexpected, stream)
+		}
+	}
+
+	if len(drift.Missing) == 0 && len(drift.Unexpected) == 0 {
+		return nil, nil
+	}
+
+	if len(drift.Missing) != 0 {
+		_, _, drift.Err = websocket.streamRequest("SUBSCRIBE", drift.Missing, timeout_sec...)
+		drift.Resubscribed = drift.Err == nil
+	}
+
+	return drift, nil
+}
+
+// # Reconciles the socket's subscriptions periodically, see Reconcile()
+//
+// Every Constants.Websocket.RECONCILE_INTERVAL_SEC by default, replaces the running reconciliation if any.
+func (websocket *Websocket) StartReconciliation(interval_sec ...int64) {
+	interval := Constants.Websocket.RECONCILE_INTERVAL_SEC
+	if len(interval_sec) != 0 && interval_sec[0] > 0 {
+		interval = interval_sec[0]
+	}
+
+	stop := make(chan struct{})
+
+	websocket.mu.Lock()
+	if websocket.reconcileStop != nil {
+		close(websocket.reconcileStop)
+	}
+	websocket.reconcileStop = stop
+	websocket.mu.Unlock()
+
+	websocket.goroutine(func() {
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-websocket.closing:
+				return
+			case <-ticker.C:
+			}
+
+			if websocket.State() != Constants.WebsocketStates.OPEN {
+				continue
+			}
+
+			_, err := websocket.Reconcile()
+			if err != nil {
+				LOG_WS_ERRORS("[*Websocket.Reconcile()] There was an error listing the subscriptions:", err.Error())
+			}
+		}
+	})
+}
+
+func (websocket *Websocket) StopReconciliation() {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	if websocket.reconcileStop != nil {
+		close(websocket.reconcileStop)
+		websocket.reconcileStop = nil
+	}
+}
+
+// Called when a reconciliation finds the subscriptions drifted
+func (websocket *Websocket) SetDriftListener(f func(drift *SubscriptionDrift)) {
+	websocket.mu.Lock()
+	defer websocket.mu.Unlock()
+
+	websocket.handlers.onDrift = f
+}
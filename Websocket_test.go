@@ -0,0 +1,405 @@
+package Binance
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ws "github.com/gorilla/websocket"
+)
+
+// Serves every websocket connection with "handler", returns the URL to dial
+func newTestWebsocketServer(t *testing.T, handler func(conn *ws.Conn)) string {
+	upgrader := ws.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		handler(conn)
+	}))
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http") + "/"
+}
+
+// Sets the websocket constants for the test, restored once it ends
+//
+// The sockets reading them must be closed with closeAndWait()
+func setWebsocketConstants(t *testing.T, set func(constants *WebsocketConstants)) {
+	previous := Constants.Websocket
+	set(&Constants.Websocket)
+	t.Cleanup(func() { Constants.Websocket = previous })
+}
+
+// Closes the socket and waits for all of its goroutines to return
+func (websocket *Websocket) closeAndWait() {
+	websocket.Close()
+	websocket.routines.Wait()
+}
+
+// A message broadcast to every connection of a testBroadcaster
+type testBroadcast struct {
+	msg    string
+	sentAt time.Time
+}
+
+// Sends the same messages to every open connection, each connection with its own constant latency
+type testBroadcaster struct {
+	mu          sync.Mutex
+	connections map[chan testBroadcast]bool
+	dialed      int
+}
+
+func (broadcaster *testBroadcaster) serve(conn *ws.Conn) {
+	messages := make(chan testBroadcast, 1<<16)
+
+	broadcaster.mu.Lock()
+	broadcaster.connections[messages] = true
+	// 0, 7, 14ms... so that the connections are alternately ahead and behind
+	latency := time.Duration(broadcaster.dialed%3*7) * time.Millisecond
+	broadcaster.dialed++
+	broadcaster.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+	defer func() {
+		broadcaster.mu.Lock()
+		delete(broadcaster.connections, messages)
+		broadcaster.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-done:
+			return
+		case message := <-messages:
+			time.Sleep(time.Until(message.sentAt.Add(latency)))
+			if conn.WriteMessage(ws.TextMessage, []byte(message.msg)) != nil {
+				return
+			}
+		}
+	}
+}
+
+func (broadcaster *testBroadcaster) broadcast(msgs ...string) {
+	now := time.Now()
+
+	broadcaster.mu.Lock()
+	defer broadcaster.mu.Unlock()
+
+	for messages := range broadcaster.connections {
+		for _, msg := range msgs {
+			messages <- testBroadcast{msg: msg, sentAt: now}
+		}
+	}
+}
+
+func TestWebsocketRotationDeliversEveryEventOnceInOrder(t *testing.T) {
+	setWebsocketConstants(t, func(constants *WebsocketConstants) {
+		constants.HEARTBEAT_CHECK_INTERVAL_SEC = 1
+		constants.EXPECTED_DISCONNECTION_TIME_SEC = 1
+		constants.ROTATION_OVERLAP_SEC = 1
+	})
+
+	broadcaster := &testBroadcaster{connections: make(map[chan testBroadcast]bool)}
+	url := newTestWebsocketServer(t, broadcaster.serve)
+
+	socket, err := CreateSocket(url, []string{"btcusdt@depth", "btcusdt@kline_1m"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	var calling bool
+	var failures []string
+	var lastUpdateId int64
+	klines := make(map[int64]int)
+	socket.SetStreamMessageListener(func(stream string, msg []byte) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if calling {
+			failures = append(failures, "the listener was called concurrently")
+		}
+		calling = true
+		defer func() { calling = false }()
+
+		switch stream {
+		case "btcusdt@depth":
+			updateId := numberAt(msg, "u")
+			if lastUpdateId != 0 && updateId != lastUpdateId+1 {
+				failures = append(failures, fmt.Sprintf("update %d received after %d", updateId, lastUpdateId))
+			}
+			lastUpdateId = updateId
+		case "btcusdt@kline_1m":
+			klines[numberAt(msg, "E")]++
+		}
+	})
+
+	stop := time.After(5 * time.Second)
+	for updateId := int64(1); ; updateId++ {
+		select {
+		case <-stop:
+		case <-time.After(time.Millisecond):
+			msgs := []string{fmt.Sprintf(`{"stream":"btcusdt@depth","data":{"e":"depthUpdate","E":%d,"U":%d,"u":%d}}`, updateId, updateId, updateId)}
+			// Two identical events, both must be delivered
+			if updateId%10 == 0 {
+				kline := fmt.Sprintf(`{"stream":"btcusdt@kline_1m","data":{"e":"kline","E":%d}}`, updateId)
+				msgs = append(msgs, kline, kline)
+			}
+			broadcaster.broadcast(msgs...)
+			continue
+		}
+		break
+	}
+	time.Sleep(100 * time.Millisecond)
+	socket.closeAndWait()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	broadcaster.mu.Lock()
+	dialed := broadcaster.dialed
+	broadcaster.mu.Unlock()
+	if dialed < 3 {
+		t.Fatalf("expected at least 2 rotations, %d connections were dialed", dialed)
+	}
+
+	for eventTime, count := range klines {
+		if count != 2 {
+			failures = append(failures, fmt.Sprintf("kline %d delivered %d times instead of 2", eventTime, count))
+		}
+	}
+	for _, failure := range failures {
+		t.Error(failure)
+	}
+}
+
+// Answers every request with its own id, "onConnection" is called with each connection before it is served
+func newTestRequestServer(t *testing.T, onConnection func(conn *ws.Conn)) string {
+	return newTestWebsocketServer(t, func(conn *ws.Conn) {
+		if onConnection != nil {
+			onConnection(conn)
+		}
+
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			Id := json.Get(msg, "id").ToString()
+			if conn.WriteMessage(ws.TextMessage, []byte(fmt.Sprintf(`{"id":"%s","status":200,"result":null}`, Id))) != nil {
+				return
+			}
+		}
+	})
+}
+
+// Creates a socket whose requests are resolved by their id, closed once the test ends
+func newTestRequestSocket(t *testing.T, url string) *Websocket {
+	socket, err := CreateSocket(url, []string{"btcusdt@depth"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	socket.setPrivateMessageValidator(func(msg []byte) (isPrivate bool, Id string) {
+		Id = json.Get(msg, "id").ToString()
+		return Id != "", Id
+	})
+	t.Cleanup(func() {
+		if socket.State() != Constants.WebsocketStates.CLOSED {
+			socket.Close()
+		}
+		socket.routines.Wait()
+	})
+
+	return socket
+}
+
+func TestWebsocketConcurrentSendRequest(t *testing.T) {
+	setWebsocketConstants(t, func(constants *WebsocketConstants) {
+		constants.MAX_OUTGOING_MESSAGES_PER_SECOND = 1000
+	})
+
+	socket := newTestRequestSocket(t, newTestRequestServer(t, nil))
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 20*25)
+	for sender := 0; sender < 20; sender++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for request := 0; request < 25; request++ {
+				Id := fmt.Sprintf("%d-%d", sender, request)
+				data, _, err := socket.SendRequest_sync(map[string]interface{}{"id": Id, "method": "PING"})
+				if err != nil {
+					errs <- fmt.Sprintf("request %s failed: %s", Id, err.Message)
+					continue
+				}
+				if received := json.Get(data, "id").ToString(); received != Id {
+					errs <- fmt.Sprintf("request %s received the response of %s", Id, received)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestWebsocketReconnectDuringWrites(t *testing.T) {
+	setWebsocketConstants(t, func(constants *WebsocketConstants) {
+		constants.MAX_OUTGOING_MESSAGES_PER_SECOND = 1000
+	})
+
+	var dialed atomic.Int64
+	socket := newTestRequestSocket(t, newTestRequestServer(t, func(conn *ws.Conn) { dialed.Add(1) }))
+
+	var reconnected atomic.Int64
+	socket.SetReconnectListener(func() { reconnected.Add(1) })
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	var answered atomic.Int64
+	for sender := 0; sender < 8; sender++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for request := 0; ; request++ {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				// Requests may fail while the socket reconnects, but never race or hang
+				_, _, err := socket.SendRequest_sync(map[string]interface{}{"id": fmt.Sprintf("%d-%d", sender, request), "method": "PING"}, 2)
+				if err == nil {
+					answered.Add(1)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 5; i++ {
+		time.Sleep(50 * time.Millisecond)
+		socket.Reconnect()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(stop)
+	wg.Wait()
+
+	if state := socket.State(); state != Constants.WebsocketStates.OPEN {
+		t.Fatalf("expected the socket to be open after reconnecting, its state is '%s'", state)
+	}
+	if reconnected.Load() != 5 || dialed.Load() != 6 {
+		t.Fatalf("expected 5 reconnections and 6 connections, got %d reconnections and %d connections", reconnected.Load(), dialed.Load())
+	}
+	if answered.Load() == 0 {
+		t.Fatal("no request was answered")
+	}
+	if _, _, err := socket.SendRequest_sync(map[string]interface{}{"id": "last", "method": "PING"}); err != nil {
+		t.Fatalf("request after reconnecting failed: %s", err.Message)
+	}
+}
+
+func TestWebsocketCloseDuringReconnect(t *testing.T) {
+	// The second connection is held before its handshake until the socket is closed
+	dialing := make(chan struct{})
+	release := make(chan struct{})
+	served := make(chan error, 2)
+	var dialed atomic.Int64
+	upgrader := ws.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if dialed.Add(1) == 2 {
+			close(dialing)
+			<-release
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		// Returns once the client drops the connection
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				served <- err
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	socket := newTestRequestSocket(t, "ws"+strings.TrimPrefix(server.URL, "http")+"/")
+
+	var reconnected atomic.Bool
+	socket.SetReconnectListener(func() { reconnected.Store(true) })
+	var closes atomic.Int64
+	socket.SetCloseListener(func(code int, text string) { closes.Add(1) })
+
+	reconnecting := make(chan struct{})
+	go func() {
+		defer close(reconnecting)
+		socket.Reconnect()
+	}()
+
+	select {
+	case <-dialing:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the socket did not redial")
+	}
+	if state := socket.State(); state != Constants.WebsocketStates.RECONNECTING {
+		t.Fatalf("expected the socket to be reconnecting, its state is '%s'", state)
+	}
+
+	if err := socket.Close(); err != nil {
+		t.Fatal(err)
+	}
+	close(release)
+
+	select {
+	case <-reconnecting:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Reconnect() did not return after the socket was closed")
+	}
+	socket.routines.Wait()
+
+	// The first connection, then the one dialed while closing
+	for i := 0; i < 2; i++ {
+		select {
+		case <-served:
+		case <-time.After(5 * time.Second):
+			t.Fatal("a connection was left open after the socket was closed")
+		}
+	}
+
+	if state := socket.State(); state != Constants.WebsocketStates.CLOSED {
+		t.Fatalf("expected the socket to stay closed, its state is '%s'", state)
+	}
+	if reconnected.Load() {
+		t.Fatal("the reconnect listener was called after the socket was closed")
+	}
+	if closes.Load() != 1 {
+		t.Fatalf("expected the close listener to be called once, it was called %d times", closes.Load())
+	}
+	if _, _, err := socket.SendRequest_sync(map[string]interface{}{"id": "closed", "method": "PING"}); err == nil {
+		t.Fatal("a request was sent on a closed socket")
+	}
+}
@@ -2,14 +2,12 @@ package Binance
 
 import (
 	"fmt"
-	"math"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"slices"
-
-	"github.com/google/uuid"
-	ws "github.com/gorilla/websocket"
 )
 
 type Futures_Websockets struct {
@@ -18,11 +16,8 @@ type Futures_Websockets struct {
 
 type Futures_Websocket struct {
 	Websocket *Websocket
-	Conn      *ws.Conn
 	// Host server's URL
 	BaseURL string
-
-	pendingRequests map[string]chan []byte
 }
 
 func (futures_ws *Futures_Websocket) Close() error {
@@ -30,46 +25,69 @@ func (futures_ws *Futures_Websocket) Close() error {
 }
 
 // Forcefully reconnects the socket
-// Also makes it a reconnecting socket if it weren't before
-// Useless, but there nonetheless...
+// Does nothing if the socket is closed or already reconnecting
 func (futures_ws *Futures_Websocket) Reconnect() {
 	futures_ws.Websocket.Reconnect()
 }
 
 func (futures_ws *Futures_Websocket) SetMessageListener(f func(messageType int, msg []byte)) {
-	futures_ws.Websocket.OnMessage = f
+	futures_ws.Websocket.SetMessageListener(f)
+}
+
+// Receives every stream message with its stream's name, see Websocket.SetStreamMessageListener()
+func (futures_ws *Futures_Websocket) SetStreamMessageListener(f func(stream string, msg []byte)) {
+	futures_ws.Websocket.SetStreamMessageListener(f)
+}
+
+// Receives the messages that could not be decoded, they are logged otherwise
+func (futures_ws *Futures_Websocket) SetErrorListener(f func(err *WebsocketDecodeError)) {
+	futures_ws.Websocket.SetErrorListener(f)
+}
+
+// Called when a reconciliation finds the subscriptions drifted, see Websocket.Reconcile()
+func (futures_ws *Futures_Websocket) SetDriftListener(f func(drift *SubscriptionDrift)) {
+	futures_ws.Websocket.SetDriftListener(f)
+}
+
+// Reconciles the socket's subscriptions with Binance's every "interval_sec", see Websocket.StartReconciliation()
+func (futures_ws *Futures_Websocket) StartReconciliation(interval_sec ...int64) {
+	futures_ws.Websocket.StartReconciliation(interval_sec...)
+}
+
+func (futures_ws *Futures_Websocket) StopReconciliation() {
+	futures_ws.Websocket.StopReconciliation()
 }
 
 func (futures_ws *Futures_Websocket) SetPingListener(f func(appData string)) {
-	futures_ws.Websocket.OnPing = f
+	futures_ws.Websocket.SetPingListener(f)
 }
 
 func (futures_ws *Futures_Websocket) SetPongListener(f func(appData string)) {
-	futures_ws.Websocket.OnPong = f
+	futures_ws.Websocket.SetPongListener(f)
 }
 
 // This is called when socket has been disconnected
 // Called when the detected a disconnection and wants to reconnect afterwards
 // Usually called right before the 'ReconnectingListener'
 func (futures_ws *Futures_Websocket) SetDisconnectListener(f func(code int, text string)) {
-	futures_ws.Websocket.OnDisconnect = f
+	futures_ws.Websocket.SetDisconnectListener(f)
 }
 
 // This is called when socket began reconnecting
 func (futures_ws *Futures_Websocket) SetReconnectingListener(f func()) {
-	futures_ws.Websocket.OnReconnecting = f
+	futures_ws.Websocket.SetReconnectingListener(f)
 }
 
 // This is called when the socket has successfully reconnected after a disconnection
 func (futures_ws *Futures_Websocket) SetReconnectListener(f func()) {
-	futures_ws.Websocket.OnReconnect = f
+	futures_ws.Websocket.SetReconnectListener(f)
 }
 
 // This is called when the websocket closes indefinitely
 // Meaning when you invoke the 'Close()' method
 // Or any other way a websocket is set to never reconnect on a disconnection
 func (futures_ws *Futures_Websocket) SetCloseListener(f func(code int, text string)) {
-	futures_ws.Websocket.OnClose = f
+	futures_ws.Websocket.SetCloseListener(f)
 }
 
 type FuturesWS_PrivateMessage struct {
@@ -111,10 +129,24 @@ type FuturesWS_AggTrade struct {
 
 	// Is the buyer the market maker?
 	IsMaker bool `json:"m"`
+
+	// Fetched through the REST API after a reconnection, see SetBackfill()
+	IsBackfilled bool `json:"-"`
 }
 
 type FuturesWS_AggTrade_Socket struct {
 	Handler *Futures_Websocket
+
+	backfill *streamBackfill[*FuturesWS_AggTrade]
+}
+
+// # Backfills the aggregate trades missed while reconnecting, disabled by default
+//
+// They are fetched from the last AggTradeId received and delivered to the same listener, in order and before the live ones, with IsBackfilled set.
+//
+// USD-M Futures sockets only.
+func (socket *FuturesWS_AggTrade_Socket) SetBackfill(enabled bool) {
+	socket.backfill.setEnabled(enabled)
 }
 
 func (*FuturesWS_AggTrade_Socket) CreateStreamName(symbol ...string) []string {
@@ -145,20 +177,62 @@ func (futures_ws *Futures_Websockets) AggTrade(publicOnMessage func(aggTrade *Fu
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	newSocket.backfill = &streamBackfill[*FuturesWS_AggTrade]{
+		deliver:  publicOnMessage,
+		key:      func(aggTrade *FuturesWS_AggTrade) string { return aggTrade.Symbol },
+		sequence: func(aggTrade *FuturesWS_AggTrade) int64 { return aggTrade.AggTradeId },
+		fetch: func(last *FuturesWS_AggTrade) ([]*FuturesWS_AggTrade, *Error) {
+			return futures_ws.backfillAggTrades(last)
+		},
+	}
+	newSocket.backfill.attach(socket.Websocket)
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var aggTrade FuturesWS_AggTrade
 		err := json.Unmarshal(msg, &aggTrade)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
-		publicOnMessage(&aggTrade)
-	}
+		newSocket.backfill.onMessage(&aggTrade)
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
 }
 
+func (futures_ws *Futures_Websockets) backfillAggTrades(last *FuturesWS_AggTrade) ([]*FuturesWS_AggTrade, *Error) {
+	const limit = 1000
+
+	aggTrades, err := fetchPages(limit,
+		func(fromId int64) ([]*Futures_AggTrade, *Error) {
+			aggTrades, _, err := futures_ws.binance.Futures.AggTrades(last.Symbol, Futures_AggTrade_Params{FromId: fromId, Limit: limit})
+			return aggTrades, err
+		},
+		last.AggTradeId+1,
+		func(aggTrade *Futures_AggTrade) int64 { return aggTrade.AggTradeId + 1 },
+	)
+
+	backfilled := make([]*FuturesWS_AggTrade, len(aggTrades))
+	for i, aggTrade := range aggTrades {
+		backfilled[i] = &FuturesWS_AggTrade{
+			Event:        last.Event,
+			EventTime:    aggTrade.Timestamp,
+			Symbol:       last.Symbol,
+			AggTradeId:   aggTrade.AggTradeId,
+			Price:        aggTrade.Price.String(),
+			Quantity:     aggTrade.Qty.String(),
+			FirstTradeId: aggTrade.FirstTradeId,
+			LastTradeId:  aggTrade.LastTradeId,
+			Timestamp:    aggTrade.Timestamp,
+			IsMaker:      aggTrade.IsBuyerMaker,
+			IsBackfilled: true,
+		}
+	}
+
+	return backfilled, err
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type FuturesWS_MarkPrice struct {
@@ -228,15 +302,15 @@ func (futures_ws *Futures_Websockets) MarkPrice(publicOnMessage func(markPrice *
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var markPrice FuturesWS_MarkPrice
 		err := json.Unmarshal(msg, &markPrice)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(&markPrice)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -286,15 +360,15 @@ func (futures_ws *Futures_Websockets) AllMarkPrices(publicOnMessage func(markPri
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var markPrices []*FuturesWS_MarkPrice
 		err := json.Unmarshal(msg, &markPrices)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(markPrices)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -314,6 +388,11 @@ type FuturesWS_Candlestick struct {
 	Symbol string `json:"s"`
 
 	Kline *FuturesWS_Candlestick_Kline `json:"k"`
+
+	// Fetched through the REST API after a reconnection, see SetBackfill()
+	//
+	// The REST API doesn't return the candle's FirstTradeId and LastTradeId, they are 0
+	IsBackfilled bool `json:"-"`
 }
 type FuturesWS_Candlestick_Kline struct {
 
@@ -371,6 +450,18 @@ type FuturesWS_Candlestick_Kline struct {
 
 type FuturesWS_Candlesticks_Socket struct {
 	Handler *Futures_Websocket
+
+	backfill *streamBackfill[*FuturesWS_Candlestick]
+}
+
+// # Backfills the candles missed while reconnecting, disabled by default
+//
+// They are fetched from the last candle received (included, as it may have closed meanwhile)
+// and delivered to the same listener, in order and before the live ones, with IsBackfilled set.
+//
+// USD-M Futures sockets only.
+func (socket *FuturesWS_Candlesticks_Socket) SetBackfill(enabled bool) {
+	socket.backfill.setEnabled(enabled)
 }
 
 type FuturesWS_Candlestick_Params struct {
@@ -406,20 +497,79 @@ func (futures_ws *Futures_Websockets) Candlesticks(publicOnMessage func(candlest
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	newSocket.backfill = &streamBackfill[*FuturesWS_Candlestick]{
+		deliver:     publicOnMessage,
+		key:         func(kline *FuturesWS_Candlestick) string { return kline.Symbol + "@" + kline.Kline.Interval },
+		sequence:    func(kline *FuturesWS_Candlestick) int64 { return kline.Kline.OpenTime },
+		allowRepeat: true,
+		fetch: func(last *FuturesWS_Candlestick) ([]*FuturesWS_Candlestick, *Error) {
+			return futures_ws.backfillCandlesticks(last)
+		},
+	}
+	newSocket.backfill.attach(socket.Websocket)
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var kline *FuturesWS_Candlestick
 		err := json.Unmarshal(msg, &kline)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
-		publicOnMessage(kline)
-	}
+		if kline == nil || kline.Kline == nil {
+			publicOnMessage(kline)
+			return
+		}
+		newSocket.backfill.onMessage(kline)
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
 }
 
+func (futures_ws *Futures_Websockets) backfillCandlesticks(last *FuturesWS_Candlestick) ([]*FuturesWS_Candlestick, *Error) {
+	const limit = 1500
+
+	candlesticks, err := fetchPages(limit,
+		func(startTime int64) ([]*Futures_Candlestick, *Error) {
+			candlesticks, _, err := futures_ws.binance.Futures.Candlesticks(last.Symbol, last.Kline.Interval, Futures_Candlesticks_Params{StartTime: startTime, Limit: limit})
+			return candlesticks, err
+		},
+		last.Kline.OpenTime,
+		func(candlestick *Futures_Candlestick) int64 { return candlestick.OpenTime + 1 },
+	)
+
+	now := time.Now().UnixMilli()
+
+	backfilled := make([]*FuturesWS_Candlestick, len(candlesticks))
+	for i, candlestick := range candlesticks {
+		backfilled[i] = &FuturesWS_Candlestick{
+			Event:     last.Event,
+			EventTime: now,
+			Symbol:    last.Symbol,
+			Kline: &FuturesWS_Candlestick_Kline{
+				Symbol:                   last.Symbol,
+				OpenTime:                 candlestick.OpenTime,
+				CloseTime:                candlestick.CloseTime,
+				IsClosed:                 candlestick.CloseTime < now,
+				Interval:                 last.Kline.Interval,
+				Open:                     candlestick.Open.String(),
+				Close:                    candlestick.Close.String(),
+				High:                     candlestick.High.String(),
+				Low:                      candlestick.Low.String(),
+				TradeCount:               candlestick.TradeCount,
+				BaseAssetVolume:          candlestick.Volume.String(),
+				QuoteAssetVolume:         candlestick.QuoteAssetVolume.String(),
+				TakerBuyBaseAssetVolume:  candlestick.TakerBuyBaseAssetVolume.String(),
+				TakerBuyQuoteAssetVolume: candlestick.TakerBuyQuoteAssetVolume.String(),
+				Ignore:                   candlestick.Unused,
+			},
+			IsBackfilled: true,
+		}
+	}
+
+	return backfilled, err
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type FuturesWS_ContinuousCandlestick struct {
@@ -530,15 +680,15 @@ func (futures_ws *Futures_Websockets) ContinuousCandlesticks(publicOnMessage fun
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var kline *FuturesWS_ContinuousCandlestick
 		err := json.Unmarshal(msg, &kline)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(kline)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -608,15 +758,15 @@ func (futures_ws *Futures_Websockets) MiniTicker(publicOnMessage func(miniTicker
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var miniTicker *FuturesWS_MiniTicker
 		err := json.Unmarshal(msg, &miniTicker)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(miniTicker)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -650,15 +800,15 @@ func (futures_ws *Futures_Websockets) AllMiniTickers(publicOnMessage func(miniTi
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var miniTickers []*FuturesWS_MiniTicker
 		err := json.Unmarshal(msg, &miniTickers)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(miniTickers)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -755,15 +905,15 @@ func (futures_ws *Futures_Websockets) Ticker(publicOnMessage func(ticker *Future
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var ticker *FuturesWS_Ticker
 		err := json.Unmarshal(msg, &ticker)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(ticker)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -797,15 +947,15 @@ func (futures_ws *Futures_Websockets) AllTickers(publicOnMessage func(tickers []
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var tickers []*FuturesWS_Ticker
 		err := json.Unmarshal(msg, &tickers)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(tickers)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -873,15 +1023,15 @@ func (futures_ws *Futures_Websockets) BookTicker(publicOnMessage func(bookTicker
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var bookTicker FuturesWS_BookTicker
 		err := json.Unmarshal(msg, &bookTicker)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(&bookTicker)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -917,15 +1067,15 @@ func (futures_ws *Futures_Websockets) AllBookTickers(publicOnMessage func(bookTi
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var bookTickers []*FuturesWS_BookTicker
 		err := json.Unmarshal(msg, &bookTickers)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(bookTickers)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1012,15 +1162,15 @@ func (futures_ws *Futures_Websockets) LiquidationOrders(publicOnMessage func(liq
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var liquidationOrder *FuturesWS_LiquidationOrder
 		err := json.Unmarshal(msg, &liquidationOrder)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(liquidationOrder)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1054,15 +1204,15 @@ func (futures_ws *Futures_Websockets) AllLiquidationOrders(publicOnMessage func(
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var liquidationOrder *FuturesWS_LiquidationOrder
 		err := json.Unmarshal(msg, &liquidationOrder)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(liquidationOrder)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1157,15 +1307,15 @@ func (futures_ws *Futures_Websockets) PartialBookDepth(publicOnMessage func(part
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var partialBookDepth *FuturesWS_PartialBookDepth
 		err := json.Unmarshal(msg, &partialBookDepth)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(partialBookDepth)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1257,15 +1407,15 @@ func (futures_ws *Futures_Websockets) DiffBookDepth(publicOnMessage func(diffBoo
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var diffBookDepth *FuturesWS_DiffBookDepth
 		err := json.Unmarshal(msg, &diffBookDepth)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(diffBookDepth)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1341,15 +1491,15 @@ func (futures_ws *Futures_Websockets) CompositeIndexSymbolInfo(publicOnMessage f
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var compositeIndexSymbolInfo *FuturesWS_CompositeIndexSymbolInfo
 		err := json.Unmarshal(msg, &compositeIndexSymbolInfo)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(compositeIndexSymbolInfo)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1435,15 +1585,15 @@ func (futures_ws *Futures_Websockets) ContractInfo(publicOnMessage func(contract
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var aggTrade FuturesWS_ContractInfo
 		err := json.Unmarshal(msg, &aggTrade)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(&aggTrade)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1520,15 +1670,15 @@ func (futures_ws *Futures_Websockets) MultiAssetsModeAssetIndex(publicOnMessage
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var assetIndexes []*FuturesWS_MultiAssetsModeAssetIndex
 		err := json.Unmarshal(msg, &assetIndexes)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(assetIndexes)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1562,15 +1712,15 @@ func (futures_ws *Futures_Websockets) AllMultiAssetsModeAssetIndexes(publicOnMes
 		return nil, err
 	}
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var assetIndexes []*FuturesWS_MultiAssetsModeAssetIndex
 		err := json.Unmarshal(msg, &assetIndexes)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 		publicOnMessage(assetIndexes)
-	}
+	})
 
 	newSocket.Handler = socket
 	return &newSocket, nil
@@ -1583,8 +1733,8 @@ func (futures_ws *Futures_Websockets) AllMultiAssetsModeAssetIndexes(publicOnMes
 type Futures_ManagedOrderbook struct {
 	Symbol       string
 	LastUpdateId int64
-	Bids         [][2]float64
-	Asks         [][2]float64
+	Bids         [][2]Decimal
+	Asks         [][2]Decimal
 
 	isReadyToUpdate bool
 	isFetching      bool
@@ -1593,18 +1743,23 @@ type Futures_ManagedOrderbook struct {
 }
 
 func (managedOrderBook *Futures_ManagedOrderbook) addEvent(event *FuturesWS_DiffBookDepth) {
-	var new_bidAsk_placeholder [2]float64
+	var new_bidAsk_placeholder [2]Decimal
 
 	for _, newBid_str := range event.Bids {
-		new_bidAsk_placeholder[0], _ = ParseFloat(newBid_str[0])
-		new_bidAsk_placeholder[1], _ = ParseFloat(newBid_str[1])
+		var parser decimalParser
+		new_bidAsk_placeholder[0] = parser.parse("bid price", newBid_str[0])
+		new_bidAsk_placeholder[1] = parser.parse("bid quantity", newBid_str[1])
+		if parser.err != nil {
+			LOG_WS_ERRORS(fmt.Sprintf("[MANAGEDORDERBOOK] '%s' skipping a bid: %s", managedOrderBook.Symbol, parser.err.Error()))
+			continue
+		}
 
 		found := false
 		for i, bid := range managedOrderBook.Bids {
-			if bid[0] == new_bidAsk_placeholder[0] {
+			if bid[0].Equal(new_bidAsk_placeholder[0]) {
 				found = true
 
-				if new_bidAsk_placeholder[1] == 0 {
+				if new_bidAsk_placeholder[1].IsZero() {
 					managedOrderBook.Bids = slices.Delete(managedOrderBook.Bids, i, i+1)
 				} else {
 					managedOrderBook.Bids[i][1] = new_bidAsk_placeholder[1]
@@ -1613,13 +1768,13 @@ func (managedOrderBook *Futures_ManagedOrderbook) addEvent(event *FuturesWS_Diff
 		}
 
 		if !found {
-			if new_bidAsk_placeholder[1] != 0 {
+			if !new_bidAsk_placeholder[1].IsZero() {
 				inserted := false
 				for i := range managedOrderBook.Bids {
 					// Compare the price of the new bid with the existing bid
-					if managedOrderBook.Bids[i][0] < new_bidAsk_placeholder[0] {
+					if managedOrderBook.Bids[i][0].LessThan(new_bidAsk_placeholder[0]) {
 						// Insert the new bid before this index
-						managedOrderBook.Bids = append(managedOrderBook.Bids[:i], append([][2]float64{new_bidAsk_placeholder}, managedOrderBook.Bids[i:]...)...)
+						managedOrderBook.Bids = append(managedOrderBook.Bids[:i], append([][2]Decimal{new_bidAsk_placeholder}, managedOrderBook.Bids[i:]...)...)
 						inserted = true
 						break
 					}
@@ -1634,14 +1789,19 @@ func (managedOrderBook *Futures_ManagedOrderbook) addEvent(event *FuturesWS_Diff
 	}
 
 	for _, newAsk_str := range event.Asks {
-		new_bidAsk_placeholder[0], _ = ParseFloat(newAsk_str[0])
-		new_bidAsk_placeholder[1], _ = ParseFloat(newAsk_str[1])
+		var parser decimalParser
+		new_bidAsk_placeholder[0] = parser.parse("ask price", newAsk_str[0])
+		new_bidAsk_placeholder[1] = parser.parse("ask quantity", newAsk_str[1])
+		if parser.err != nil {
+			LOG_WS_ERRORS(fmt.Sprintf("[MANAGEDORDERBOOK] '%s' skipping an ask: %s", managedOrderBook.Symbol, parser.err.Error()))
+			continue
+		}
 
 		found := false
 		for i, ask := range managedOrderBook.Asks {
-			if ask[0] == new_bidAsk_placeholder[0] {
+			if ask[0].Equal(new_bidAsk_placeholder[0]) {
 
-				if new_bidAsk_placeholder[1] == 0 {
+				if new_bidAsk_placeholder[1].IsZero() {
 					managedOrderBook.Asks = slices.Delete(managedOrderBook.Asks, i, i+1)
 				} else {
 					managedOrderBook.Asks[i][1] = new_bidAsk_placeholder[1]
@@ -1651,13 +1811,13 @@ func (managedOrderBook *Futures_ManagedOrderbook) addEvent(event *FuturesWS_Diff
 		}
 
 		if !found {
-			if new_bidAsk_placeholder[1] != 0 {
+			if !new_bidAsk_placeholder[1].IsZero() {
 				inserted := false
 				for i := range managedOrderBook.Asks {
 					// Compare the price of the new ask with the existing ask
-					if managedOrderBook.Asks[i][0] > new_bidAsk_placeholder[0] {
+					if managedOrderBook.Asks[i][0].GreaterThan(new_bidAsk_placeholder[0]) {
 						// Insert the new ask before this index
-						managedOrderBook.Asks = append(managedOrderBook.Asks[:i], append([][2]float64{new_bidAsk_placeholder}, managedOrderBook.Asks[i:]...)...)
+						managedOrderBook.Asks = append(managedOrderBook.Asks[:i], append([][2]Decimal{new_bidAsk_placeholder}, managedOrderBook.Asks[i:]...)...)
 						inserted = true
 						break
 					}
@@ -1725,38 +1885,8 @@ func (handler *FuturesWS_ManagedOrderBook_Handler) handleWSMessage(futures_ws *F
 		}
 
 		Orderbook_symbol.Orderbook.LastUpdateId = newOrderBook.LastUpdateId
-		Orderbook_symbol.Orderbook.Asks = make([][2]float64, len(newOrderBook.Asks))
-		Orderbook_symbol.Orderbook.Bids = make([][2]float64, len(newOrderBook.Bids))
-
-		for i, ask := range newOrderBook.Asks {
-			priceLvl, err1 := ParseFloat(ask[0])
-			priceQty, err2 := ParseFloat(ask[1])
-			if err1 != nil {
-				LOG_WS_ERRORS(fmt.Sprintf("Failed to parse bid priceLevel '%s'", ask[0]))
-				// continue
-			}
-			if err2 != nil {
-				LOG_WS_ERRORS(fmt.Sprintf("Failed to parse bid priceQty '%s'", ask[1]))
-				// continue
-			}
-			Orderbook_symbol.Orderbook.Asks[i][0] = priceLvl
-			Orderbook_symbol.Orderbook.Asks[i][1] = priceQty
-		}
-
-		for i, bid := range newOrderBook.Bids {
-			priceLvl, err1 := ParseFloat(bid[0])
-			priceQty, err2 := ParseFloat(bid[1])
-			if err1 != nil {
-				LOG_WS_ERRORS(fmt.Sprintf("Failed to parse ask priceLevel '%s'", bid[0]))
-				// continue
-			}
-			if err2 != nil {
-				LOG_WS_ERRORS(fmt.Sprintf("Failed to parse ask priceQty '%s'", bid[1]))
-				// continue
-			}
-			Orderbook_symbol.Orderbook.Bids[i][0] = priceLvl
-			Orderbook_symbol.Orderbook.Bids[i][1] = priceQty
-		}
+		Orderbook_symbol.Orderbook.Asks = slices.Clone(newOrderBook.Asks)
+		Orderbook_symbol.Orderbook.Bids = slices.Clone(newOrderBook.Bids)
 
 		Orderbook_symbol.Orderbook.isReadyToUpdate = true
 	}
@@ -1874,11 +2004,11 @@ func (futures_ws *Futures_Websockets) Managed_OrderBook(publicOnMessage func(ord
 	}
 	newSocket.Handler = socket
 
-	socket.Websocket.OnMessage = func(messageType int, msg []byte) {
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
 		var diffBookDepth *FuturesWS_DiffBookDepth
 		err := json.Unmarshal(msg, &diffBookDepth)
 		if err != nil {
-			LocalError(PARSING_ERR, err.Error())
+			socket.Websocket.decodeError(stream, msg, err)
 			return
 		}
 
@@ -1886,7 +2016,7 @@ func (futures_ws *Futures_Websockets) Managed_OrderBook(publicOnMessage func(ord
 		if shouldPushEvent {
 			publicOnMessage(managedOrderbook)
 		}
-	}
+	})
 
 	handler.DiffBookDepth_Socket = &newSocket
 	handler.Orderbooks.Symbols = make(map[string]struct {
@@ -1956,40 +2086,40 @@ type FuturesWS_ManagedCandlestick struct {
 	OpenTime  int64
 	CloseTime int64
 
-	Open  float64
-	High  float64
-	Low   float64
-	Close float64
+	Open  Decimal
+	High  Decimal
+	Low   Decimal
+	Close Decimal
 
-	Volume                   float64
-	QuoteAssetVolume         float64
-	TakerBuyBaseAssetVolume  float64
-	TakerBuyQuoteAssetVolume float64
+	Volume                   Decimal
+	QuoteAssetVolume         Decimal
+	TakerBuyBaseAssetVolume  Decimal
+	TakerBuyQuoteAssetVolume Decimal
 	TradeCount               int64
 
 	// Calculated from the incoming aggTrades
 	//
-	// If being used, always use math.Max(Calculated_Volume, Volume)
-	Calculated_Volume float64
+	// If being used, always use the highest of Calculated_Volume and Volume
+	Calculated_Volume Decimal
 
 	// Calculated from the incoming aggTrades
 	//
-	// If being used, always use math.Max(Calculated_QuoteAssetVolume, QuoteAssetVolume)
-	Calculated_QuoteAssetVolume float64
+	// If being used, always use the highest of Calculated_QuoteAssetVolume and QuoteAssetVolume
+	Calculated_QuoteAssetVolume Decimal
 
 	// # Not sure if calculated correctly
 	//
 	// Currently calculated via adding to it ONLY if the aggTrade has 'IsMaker' as false
 	//
-	// If being used, always use math.Max(Calculated_TakerBuyBaseAssetVolume, TakerBuyBaseAssetVolume)
-	Calculated_TakerBuyBaseAssetVolume float64
+	// If being used, always use the highest of Calculated_TakerBuyBaseAssetVolume and TakerBuyBaseAssetVolume
+	Calculated_TakerBuyBaseAssetVolume Decimal
 
 	// # Not sure if calculated correctly
 	//
 	// Currently calculated via adding to it ONLY if the aggTrade has 'IsMaker' as false
 	//
-	// If being used, always use math.Max(Calculated_TakerBuyQuoteAssetVolume, TakerBuyQuoteAssetVolume)
-	Calculated_TakerBuyQuoteAssetVolume float64
+	// If being used, always use the highest of Calculated_TakerBuyQuoteAssetVolume and TakerBuyQuoteAssetVolume
+	Calculated_TakerBuyQuoteAssetVolume Decimal
 	Calculated_TradeCount               int64
 
 	AggTrades []*FuturesWS_ManagedCandlesticks_AggTrade
@@ -2002,80 +2132,72 @@ type FuturesWS_ManagedCandlesticks_AggTrade struct {
 	FirstTradeId int64
 	LastTradeId  int64
 
-	Price float64
-	Qty   float64
+	Price Decimal
+	Qty   Decimal
 }
 
 ////
 
-func parseFloat_FuturesWS_Candlestick(candlestick *FuturesWS_Candlestick) (*FuturesWS_Candlestick_Float64, error) {
-	kline := candlestick.Kline
-	open, err := ParseFloat(kline.Open)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'kline.Open' for '%s', interval '%s' in parseFloat_FuturesWS_Candlestick: %s", kline.Open, candlestick.Symbol, kline.Interval, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-	high, err := ParseFloat(kline.High)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'kline.High' for '%s', interval '%s' in parseFloat_FuturesWS_Candlestick: %s", kline.High, candlestick.Symbol, kline.Interval, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-	low, err := ParseFloat(kline.Low)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'kline.Low' for '%s', interval '%s' in parseFloat_FuturesWS_Candlestick: %s", kline.Low, candlestick.Symbol, kline.Interval, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-	close, err := ParseFloat(kline.Close)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'kline.Close' for '%s', interval '%s' in parseFloat_FuturesWS_Candlestick: %s", kline.Close, candlestick.Symbol, kline.Interval, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
+// A candlestick from the REST API or the websocket, applied to the managed candlesticks
+type managedCandlestickUpdate struct {
+	OpenTime  int64
+	CloseTime int64
 
-	baseAssetVolune, err := ParseFloat(kline.BaseAssetVolume)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'kline.BaseAssetVolume' for '%s', interval '%s' in parseFloat_FuturesWS_Candlestick: %s", kline.BaseAssetVolume, candlestick.Symbol, kline.Interval, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-	quoteAssetVolume, err := ParseFloat(kline.QuoteAssetVolume)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'kline.QuoteAssetVolume' for '%s', interval '%s' in parseFloat_FuturesWS_Candlestick: %s", kline.QuoteAssetVolume, candlestick.Symbol, kline.Interval, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
+	Open  Decimal
+	High  Decimal
+	Low   Decimal
+	Close Decimal
 
-	takerBuyBaseAssetVolume, err := ParseFloat(kline.TakerBuyBaseAssetVolume)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'kline.TakerBuyBaseAssetVolume' for '%s', interval '%s' in parseFloat_FuturesWS_Candlestick: %s", kline.TakerBuyBaseAssetVolume, candlestick.Symbol, kline.Interval, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
-	}
-	takerBuyQuoteAssetVolume, err := ParseFloat(kline.TakerBuyQuoteAssetVolume)
-	if err != nil {
-		errStr := fmt.Sprintf("There was an error parsing float '%s' of 'kline.TakerBuyQuoteAssetVolume' for '%s', interval '%s' in parseFloat_FuturesWS_Candlestick: %s", kline.TakerBuyQuoteAssetVolume, candlestick.Symbol, kline.Interval, err.Error())
-		LOG_WS_ERRORS(errStr)
-		return nil, err
+	Volume                   Decimal
+	QuoteAssetVolume         Decimal
+	TakerBuyBaseAssetVolume  Decimal
+	TakerBuyQuoteAssetVolume Decimal
+	TradeCount               int64
+}
+
+func newManagedCandlestickUpdate(candlestick *Futures_Candlestick) *managedCandlestickUpdate {
+	return &managedCandlestickUpdate{
+		OpenTime:  candlestick.OpenTime,
+		CloseTime: candlestick.CloseTime,
+
+		Open:  candlestick.Open,
+		High:  candlestick.High,
+		Low:   candlestick.Low,
+		Close: candlestick.Close,
+
+		Volume:                   candlestick.Volume,
+		QuoteAssetVolume:         candlestick.QuoteAssetVolume,
+		TakerBuyBaseAssetVolume:  candlestick.TakerBuyBaseAssetVolume,
+		TakerBuyQuoteAssetVolume: candlestick.TakerBuyQuoteAssetVolume,
+		TradeCount:               candlestick.TradeCount,
 	}
+}
+
+func parseManagedCandlestickUpdate(candlestick *FuturesWS_Candlestick) (*managedCandlestickUpdate, error) {
+	kline := candlestick.Kline
 
-	return &FuturesWS_Candlestick_Float64{
+	var parser decimalParser
+	update := &managedCandlestickUpdate{
 		OpenTime:  kline.OpenTime,
 		CloseTime: kline.CloseTime,
 
-		Open:  open,
-		High:  high,
-		Low:   low,
-		Close: close,
+		Open:  parser.parse("kline.Open", kline.Open),
+		High:  parser.parse("kline.High", kline.High),
+		Low:   parser.parse("kline.Low", kline.Low),
+		Close: parser.parse("kline.Close", kline.Close),
 
-		Volume:                   baseAssetVolune,
-		QuoteAssetVolume:         quoteAssetVolume,
-		TakerBuyBaseAssetVolume:  takerBuyBaseAssetVolume,
-		TakerBuyQuoteAssetVolume: takerBuyQuoteAssetVolume,
+		Volume:                   parser.parse("kline.BaseAssetVolume", kline.BaseAssetVolume),
+		QuoteAssetVolume:         parser.parse("kline.QuoteAssetVolume", kline.QuoteAssetVolume),
+		TakerBuyBaseAssetVolume:  parser.parse("kline.TakerBuyBaseAssetVolume", kline.TakerBuyBaseAssetVolume),
+		TakerBuyQuoteAssetVolume: parser.parse("kline.TakerBuyQuoteAssetVolume", kline.TakerBuyQuoteAssetVolume),
 		TradeCount:               kline.TradeCount,
-	}, nil
+	}
+	if parser.err != nil {
+		LOG_WS_ERRORS(fmt.Sprintf("There was an error parsing the candlestick of '%s', interval '%s': %s", candlestick.Symbol, kline.Interval, parser.err.Error()))
+		return nil, parser.err
+	}
+
+	return update, nil
 }
 
 func (interval *FuturesWS_ManagedCandlesticks_Interval) Fetch_Newest_Candlesticks() *Error {
@@ -2095,11 +2217,7 @@ func (interval *FuturesWS_ManagedCandlesticks_Interval) Fetch_Newest_Candlestick
 	}
 
 	for i := len(newRawCandlesticks) - 1; i >= 0; i-- {
-		newCandlestick, err := parseFloat_Futures_Candlestick(newRawCandlesticks[i])
-		if err != nil {
-			continue
-		}
-		interval.handleCandlestick(newCandlestick)
+		interval.handleCandlestick(newManagedCandlestickUpdate(newRawCandlesticks[i]))
 	}
 
 	return nil
@@ -2128,11 +2246,7 @@ func (interval *FuturesWS_ManagedCandlesticks_Interval) Fetch_Older_Candlesticks
 	}
 
 	for i := len(newRawCandlesticks) - 1; i >= 0; i-- {
-		newCandlestick, err := parseFloat_Futures_Candlestick(newRawCandlesticks[i])
-		if err != nil {
-			continue
-		}
-		interval.handleCandlestick(newCandlestick)
+		interval.handleCandlestick(newManagedCandlestickUpdate(newRawCandlesticks[i]))
 	}
 
 	return nil
@@ -2147,8 +2261,8 @@ func (managedCandlestick_symbol *FuturesWS_ManagedCandlesticks_Symbol) handleCan
 		return
 	}
 
-	newCandlestick, err := parseFloat_FuturesWS_Candlestick(candlestick)
-	if err == nil {
+	newCandlestick, err := parseManagedCandlestickUpdate(candlestick)
+	if err != nil {
 		return
 	}
 
@@ -2157,7 +2271,7 @@ func (managedCandlestick_symbol *FuturesWS_ManagedCandlesticks_Symbol) handleCan
 		// 1- A candlestick of a higher interval doesn't update candlesticks of a lower interval (like receiving a 3m candle and updating a 1m candle, not ideal for tradecounts and such)
 		// 2- A candlestick of the same interval rune (like 'm' for minutes) can only update similar candlesticks with the same rune IF it divides the interval (a candle of 1m can update 3m candles, but a 3m interval cannot update 5m candles)
 		//
-		// I am aware that since we are doing this, NONE of the Volumes will be updated accurately, lots of overwriting of bad data, but they will be accurate with the aggTrades received, thus the use of math.Max(<candleVolume>, <calculated_candleVolume>) is required
+		// I am aware that since we are doing this, NONE of the Volumes will be updated accurately, lots of overwriting of bad data, but they will be accurate with the aggTrades received, thus taking the highest of <candleVolume> and <calculated_candleVolume> is required
 		// But the point of this is to have as much accuracy as possible, with as little data consumption as possible
 		if storedInterval.Interval.Value < interval.Value { //  || interval.Interval.Value%intervalValue != 0
 			continue
@@ -2172,14 +2286,11 @@ func (managedCandlestick_symbol *FuturesWS_ManagedCandlesticks_Symbol) handleAgg
 	managedCandlestick_symbol.Intervals.Mu.Lock()
 	defer managedCandlestick_symbol.Intervals.Mu.Unlock()
 
-	price, err := ParseFloat(aggTrade.Price)
-	if err != nil {
-		LOG_WS_ERRORS(fmt.Sprintf("Failed to parse aggTrade.price '%s' in AddAggTrade for '%s': %s", aggTrade.Price, aggTrade.Symbol, err.Error()))
-		return
-	}
-	quantity, err := ParseFloat(aggTrade.Quantity)
-	if err != nil {
-		LOG_WS_ERRORS(fmt.Sprintf("Failed to parse aggTrade.quantity '%s' in AddAggTrade for '%s': %s", aggTrade.Price, aggTrade.Symbol, err.Error()))
+	var parser decimalParser
+	price := parser.parse("aggTrade.Price", aggTrade.Price)
+	quantity := parser.parse("aggTrade.Quantity", aggTrade.Quantity)
+	if parser.err != nil {
+		LOG_WS_ERRORS(fmt.Sprintf("Failed to parse the aggTrade in AddAggTrade for '%s': %s", aggTrade.Symbol, parser.err.Error()))
 		return
 	}
 
@@ -2203,7 +2314,7 @@ func (managedCandlestick_symbol *FuturesWS_ManagedCandlesticks_Symbol) handleAgg
 
 ////
 
-func (interval *FuturesWS_ManagedCandlesticks_Interval) handleCandlestick(newCandlestick *FuturesWS_Candlestick_Float64) {
+func (interval *FuturesWS_ManagedCandlesticks_Interval) handleCandlestick(newCandlestick *managedCandlestickUpdate) {
 	supposed_openTime, supposed_closeTime, err := GetOpenCloseTimes(newCandlestick.OpenTime, interval.Interval.Name)
 	if err != nil {
 		return
@@ -2285,10 +2396,10 @@ func (interval *FuturesWS_ManagedCandlesticks_Interval) handleCandlestick(newCan
 	}
 }
 
-func (candle *FuturesWS_ManagedCandlestick) update(newCandlestick *FuturesWS_Candlestick_Float64) {
+func (candle *FuturesWS_ManagedCandlestick) update(newCandlestick *managedCandlestickUpdate) {
 	candle.Open = newCandlestick.Open
-	candle.High = math.Max(candle.High, newCandlestick.High)
-	candle.Low = math.Min(candle.Low, newCandlestick.Low)
+	candle.High = maxDecimal(candle.High, newCandlestick.High)
+	candle.Low = minDecimal(candle.Low, newCandlestick.Low)
 	candle.Close = newCandlestick.Close
 
 	candle.Volume = newCandlestick.Volume
@@ -2384,18 +2495,18 @@ func (interval *FuturesWS_ManagedCandlesticks_Interval) handleAggTrade(aggTrade
 }
 
 func (candle *FuturesWS_ManagedCandlestick) insertAggTrade(managedAggTrade *FuturesWS_ManagedCandlesticks_AggTrade) {
-	candle.High = math.Max(candle.High, managedAggTrade.Price)
-	candle.Low = math.Min(candle.Low, managedAggTrade.Price)
+	candle.High = maxDecimal(candle.High, managedAggTrade.Price)
+	candle.Low = minDecimal(candle.Low, managedAggTrade.Price)
 	candle.Close = managedAggTrade.Price
 
-	quoteAsset_size := managedAggTrade.Qty * managedAggTrade.Price
+	quoteAsset_size := managedAggTrade.Qty.Mul(managedAggTrade.Price)
 
-	candle.Calculated_Volume += managedAggTrade.Qty
-	candle.Calculated_QuoteAssetVolume += quoteAsset_size
+	candle.Calculated_Volume = candle.Calculated_Volume.Add(managedAggTrade.Qty)
+	candle.Calculated_QuoteAssetVolume = candle.Calculated_QuoteAssetVolume.Add(quoteAsset_size)
 
 	if !managedAggTrade.IsMaker {
-		candle.Calculated_TakerBuyBaseAssetVolume += managedAggTrade.Qty
-		candle.Calculated_TakerBuyQuoteAssetVolume += quoteAsset_size
+		candle.Calculated_TakerBuyBaseAssetVolume = candle.Calculated_TakerBuyBaseAssetVolume.Add(managedAggTrade.Qty)
+		candle.Calculated_TakerBuyQuoteAssetVolume = candle.Calculated_TakerBuyQuoteAssetVolume.Add(quoteAsset_size)
 	}
 
 	candle.Calculated_TradeCount += managedAggTrade.LastTradeId - managedAggTrade.FirstTradeId
@@ -2531,7 +2642,7 @@ type Managed_CustomCandlesticks_Params struct {
 //
 // That is done by opening a candlestick stream to the smallest possible interval (here its '1m') and an aggTrade stream to fetch the much faster updates, and update all the local candlestick intervals with both streams' data.
 //
-// When using the candlestick data (and specifically the volumes and all of their varieties, make sure to use `math.Max(<propertyName>, Calculated_<propertyName>)` to get the accurate binance data)
+// When using the candlestick data (and specifically the volumes and all of their varieties, make sure to use the highest of <propertyName> and Calculated_<propertyName> to get the accurate binance data)
 //
 // NOTE: the first element (element '0') might be inaccurate since the streams might've started before fetching the full data (although rare), so make sure to wait a bit before using the first candlestick element.
 // This happens due to the fact that the aggTrade and candlestick stream might've began in the middle of the candlestick's interval; using FuturesWS_ManageCandlesticks_Symbol.Fetch_Older_Candlesticks() fixes this.
@@ -2696,134 +2807,763 @@ func (futures_ws *Futures_Websockets) Managed_CustomCandlesticks(publicOnMessage
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (*Futures_Websockets) CreateSocket(streams []string, isCombined bool) (*Futures_Websocket, *Error) {
-	baseURL := FUTURES_Constants.Websocket.URLs[0]
+type FuturesWS_UserData_Event struct {
 
-	socket, err := CreateSocket(baseURL, streams, isCombined)
-	if err != nil {
-		return nil, err
-	}
+	// Event Type
+	Event string `json:"e"`
 
-	socket.privateMessageValidator = func(msg []byte) (isPrivate bool, Id string) {
+	// Event Time
+	EventTime int64 `json:"E"`
+}
 
-		if len(msg) > 0 && msg[0] == '[' {
-			return false, ""
-		}
+type FuturesWS_ListenKeyExpired struct {
 
-		var privateMessage FuturesWS_PrivateMessage
-		err := json.Unmarshal(msg, &privateMessage)
-		if err != nil {
-			LOG_WS_ERRORS("[PRIVATEMESSAGEVALIDATOR ERR] WS Message is the following:", string(msg))
-			LocalError(PARSING_ERR, err.Error())
-			return false, ""
-		}
+	// Event Type
+	Event string `json:"e"`
 
-		if privateMessage.Id == "" {
-			return false, ""
-		}
+	// Event Time
+	EventTime int64 `json:"E"`
 
-		return true, privateMessage.Id
+	// The listenKey that has expired
+	ListenKey string `json:"listenKey"`
+}
+
+// Binance has been known to send the event time as a string for this event
+func (event *FuturesWS_ListenKeyExpired) UnmarshalJSON(data []byte) error {
+	type Alias FuturesWS_ListenKeyExpired
+	aux := &struct {
+		EventTime interface{} `json:"E"`
+		*Alias
+	}{
+		Alias: (*Alias)(event),
 	}
 
-	ws := &Futures_Websocket{
-		Websocket:       socket,
-		Conn:            socket.Conn,
-		BaseURL:         baseURL,
-		pendingRequests: make(map[string]chan []byte),
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
 
-	return ws, nil
+	switch eventTime := aux.EventTime.(type) {
+	case float64:
+		event.EventTime = int64(eventTime)
+	case string:
+		parsed, err := strconv.ParseInt(eventTime, 10, 64)
+		if err != nil {
+			return err
+		}
+		event.EventTime = parsed
+	}
+
+	return nil
 }
 
-func (futures *Futures_Websocket) createRequestObject() map[string]interface{} {
-	requestObj := make(map[string]interface{})
+type FuturesWS_MarginCall struct {
 
-	requestObj["id"] = uuid.New().String()
-	return requestObj
-}
+	// Event Type
+	Event string `json:"e"`
 
-type FuturesWS_ListSubscriptions_Response struct {
-	Id     string   `json:"id"`
-	Result []string `json:"result"`
+	// Event Time
+	EventTime int64 `json:"E"`
+
+	// Cross Wallet Balance. Only pushed with crossed position margin call
+	CrossWalletBalance string `json:"cw"`
+
+	// Position(s) of Margin Call
+	Positions []*FuturesWS_MarginCall_Position `json:"p"`
 }
 
-func (futures_ws *Futures_Websocket) ListSubscriptions(timeout_sec ...int) (resp *FuturesWS_ListSubscriptions_Response, hasTimedOut bool, err *Error) {
-	requestObj := futures_ws.createRequestObject()
-	requestObj["method"] = "LIST_SUBSCRIPTIONS"
-	data, timedOut, err := futures_ws.Websocket.SendRequest_sync(requestObj, timeout_sec...)
-	if err != nil || timedOut {
-		return nil, timedOut, err
-	}
+type FuturesWS_MarginCall_Position struct {
 
-	var response FuturesWS_ListSubscriptions_Response
-	unmarshallErr := json.Unmarshal(data, &response)
-	if unmarshallErr != nil {
-		return nil, false, LocalError(ERROR_PROCESSING_ERR, unmarshallErr.Error())
-	}
+	// Symbol
+	Symbol string `json:"s"`
 
-	return &response, false, nil
-}
+	// Position Side
+	PositionSide string `json:"ps"`
 
-type FuturesWS_Subscribe_Response struct {
-	Id string `json:"id"`
-}
+	// Position Amount
+	PositionAmount string `json:"pa"`
 
-func (futures_ws *Futures_Websocket) Subscribe(stream ...string) (resp *FuturesWS_Subscribe_Response, hasTimedOut bool, err *Error) {
-	requestObj := futures_ws.createRequestObject()
-	requestObj["method"] = "SUBSCRIBE"
-	requestObj["params"] = stream
-	data, timedOut, err := futures_ws.Websocket.SendRequest_sync(requestObj)
-	if err != nil || timedOut {
-		return nil, timedOut, err
-	}
+	// Margin Type
+	MarginType string `json:"mt"`
 
-	var response FuturesWS_Subscribe_Response
-	unmarshallErr := json.Unmarshal(data, &response)
-	if unmarshallErr != nil {
-		return nil, false, LocalError(ERROR_PROCESSING_ERR, unmarshallErr.Error())
-	}
+	// Isolated Wallet (if isolated position)
+	IsolatedWallet string `json:"iw"`
 
-	futures_ws.Websocket.Streams = append(futures_ws.Websocket.Streams, stream...)
+	// Mark Price
+	MarkPrice string `json:"mp"`
 
-	LOG_WS_VERBOSE("Successfully Subscribed to", stream)
+	// Unrealized PnL
+	UnrealizedPnL string `json:"up"`
 
-	return &response, false, nil
+	// Maintenance Margin Required
+	MaintenanceMarginRequired string `json:"mm"`
 }
 
-type FuturesWS_Unsubscribe_Response struct {
-	Id string `json:"id"`
-}
+type FuturesWS_AccountUpdate struct {
 
-func (futures_ws *Futures_Websocket) Unsubscribe(stream ...string) (resp *FuturesWS_Unsubscribe_Response, hasTimedOut bool, err *Error) {
-	requestObj := futures_ws.createRequestObject()
-	requestObj["method"] = "UNSUBSCRIBE"
-	requestObj["params"] = stream
-	data, timedOut, err := futures_ws.Websocket.SendRequest_sync(requestObj)
-	if err != nil || timedOut {
-		return nil, timedOut, err
-	}
+	// Event Type
+	Event string `json:"e"`
 
-	var response FuturesWS_Unsubscribe_Response
-	unmarshallErr := json.Unmarshal(data, &response)
-	if unmarshallErr != nil {
-		return nil, false, LocalError(ERROR_PROCESSING_ERR, unmarshallErr.Error())
-	}
+	// Event Time
+	EventTime int64 `json:"E"`
 
-	// Filter out the streams to remove from futures_ws.Websocket.Streams
-	streamMap := make(map[string]bool)
-	for _, s := range stream {
-		streamMap[s] = true
-	}
+	// Transaction Time
+	TransactionTime int64 `json:"T"`
 
-	var updatedStreams []string
-	for _, existingStream := range futures_ws.Websocket.Streams {
-		if !streamMap[existingStream] {
-			updatedStreams = append(updatedStreams, existingStream)
+	// Update Data
+	UpdateData *FuturesWS_AccountUpdate_Data `json:"a"`
+}
+
+type FuturesWS_AccountUpdate_Data struct {
+
+	// Event reason type
+	// Possible values are in FUTURES_Constants.AccountUpdateReasons
+	Reason string `json:"m"`
+
+	// Balances
+	Balances []*FuturesWS_AccountUpdate_Balance `json:"B"`
+
+	// Positions
+	Positions []*FuturesWS_AccountUpdate_Position `json:"P"`
+}
+
+type FuturesWS_AccountUpdate_Balance struct {
+
+	// Asset
+	Asset string `json:"a"`
+
+	// Wallet Balance
+	WalletBalance string `json:"wb"`
+
+	// Cross Wallet Balance
+	CrossWalletBalance string `json:"cw"`
+
+	// Balance Change except PnL and Commission
+	BalanceChange string `json:"bc"`
+}
+
+type FuturesWS_AccountUpdate_Position struct {
+
+	// Symbol
+	Symbol string `json:"s"`
+
+	// Position Amount
+	PositionAmount string `json:"pa"`
+
+	// Entry Price
+	EntryPrice string `json:"ep"`
+
+	// Breakeven Price
+	BreakevenPrice string `json:"bep"`
+
+	// (Pre-fee) Accumulated Realized
+	AccumulatedRealized string `json:"cr"`
+
+	// Unrealized PnL
+	UnrealizedPnL string `json:"up"`
+
+	// Margin Type
+	MarginType string `json:"mt"`
+
+	// Isolated Wallet (if isolated position)
+	IsolatedWallet string `json:"iw"`
+
+	// Position Side
+	PositionSide string `json:"ps"`
+}
+
+type FuturesWS_OrderTradeUpdate struct {
+
+	// Event Type
+	Event string `json:"e"`
+
+	// Event Time
+	EventTime int64 `json:"E"`
+
+	// Transaction Time
+	TransactionTime int64 `json:"T"`
+
+	Order *FuturesWS_OrderTradeUpdate_Order `json:"o"`
+}
+
+type FuturesWS_OrderTradeUpdate_Order struct {
+
+	// Symbol
+	Symbol string `json:"s"`
+
+	// Client Order Id
+	ClientOrderId string `json:"c"`
+
+	// Side
+	Side string `json:"S"`
+
+	// Order Type
+	OrderType string `json:"o"`
+
+	// Time in Force
+	TimeInForce string `json:"f"`
+
+	// Original Quantity
+	OriginalQuantity string `json:"q"`
+
+	// Original Price
+	OriginalPrice string `json:"p"`
+
+	// Average Price
+	AveragePrice string `json:"ap"`
+
+	// Stop Price. Please ignore with TRAILING_STOP_MARKET order
+	StopPrice string `json:"sp"`
+
+	// Execution Type
+	// Possible values are in FUTURES_Constants.ExecutionTypes
+	ExecutionType string `json:"x"`
+
+	// Order Status
+	OrderStatus string `json:"X"`
+
+	// Order Id
+	OrderId int64 `json:"i"`
+
+	// Order Last Filled Quantity
+	LastFilledQuantity string `json:"l"`
+
+	// Order Filled Accumulated Quantity
+	FilledAccumulatedQuantity string `json:"z"`
+
+	// Last Filled Price
+	LastFilledPrice string `json:"L"`
+
+	// Commission Asset, will not push if no commission
+	CommissionAsset string `json:"N"`
+
+	// Commission, will not push if no commission
+	Commission string `json:"n"`
+
+	// Order Trade Time
+	OrderTradeTime int64 `json:"T"`
+
+	// Trade Id
+	TradeId int64 `json:"t"`
+
+	// Bids Notional
+	BidsNotional string `json:"b"`
+
+	// Ask Notional
+	AskNotional string `json:"a"`
+
+	// Is this trade the maker side?
+	IsMaker bool `json:"m"`
+
+	// Is this reduce only
+	IsReduceOnly bool `json:"R"`
+
+	// Stop Price Working Type
+	WorkingType string `json:"wt"`
+
+	// Original Order Type
+	OriginalOrderType string `json:"ot"`
+
+	// Position Side
+	PositionSide string `json:"ps"`
+
+	// If Close-All, pushed with conditional order
+	IsCloseAll bool `json:"cp"`
+
+	// Activation Price, only pushed with TRAILING_STOP_MARKET order
+	ActivationPrice string `json:"AP"`
+
+	// Callback Rate, only pushed with TRAILING_STOP_MARKET order
+	CallbackRate string `json:"cr"`
+
+	// If price protection is turned on
+	PriceProtect bool `json:"pP"`
+
+	// Ignore
+	Si int64 `json:"si"`
+
+	// Ignore
+	Ss int64 `json:"ss"`
+
+	// Realized Profit of the trade
+	RealizedProfit string `json:"rp"`
+
+	// STP mode
+	SelfTradePreventionMode string `json:"V"`
+
+	// Price match mode
+	PriceMatch string `json:"pm"`
+
+	// TIF GTD order auto cancel time
+	GoodTillDate int64 `json:"gtd"`
+}
+
+type FuturesWS_TradeLite struct {
+
+	// Event Type
+	Event string `json:"e"`
+
+	// Event Time
+	EventTime int64 `json:"E"`
+
+	// Transaction Time
+	TransactionTime int64 `json:"T"`
+
+	// Symbol
+	Symbol string `json:"s"`
+
+	// Original Quantity
+	OriginalQuantity string `json:"q"`
+
+	// Original Price
+	OriginalPrice string `json:"p"`
+
+	// Is this trade the maker side?
+	IsMaker bool `json:"m"`
+
+	// Client Order Id
+	ClientOrderId string `json:"c"`
+
+	// Side
+	Side string `json:"S"`
+
+	// Last Filled Price
+	LastFilledPrice string `json:"L"`
+
+	// Order Last Filled Quantity
+	LastFilledQuantity string `json:"l"`
+
+	// Trade Id
+	TradeId int64 `json:"t"`
+
+	// Order Id
+	OrderId int64 `json:"i"`
+}
+
+type FuturesWS_AccountConfigUpdate struct {
+
+	// Event Type
+	Event string `json:"e"`
+
+	// Event Time
+	EventTime int64 `json:"E"`
+
+	// Transaction Time
+	TransactionTime int64 `json:"T"`
+
+	// Only pushed when the leverage of a symbol changes
+	AccountConfig *FuturesWS_AccountConfigUpdate_AccountConfig `json:"ac"`
+
+	// Only pushed when the Multi-Assets margin mode changes
+	AccountInfo *FuturesWS_AccountConfigUpdate_AccountInfo `json:"ai"`
+}
+
+type FuturesWS_AccountConfigUpdate_AccountConfig struct {
+
+	// Symbol
+	Symbol string `json:"s"`
+
+	// Leverage
+	Leverage int64 `json:"l"`
+}
+
+type FuturesWS_AccountConfigUpdate_AccountInfo struct {
+
+	// Multi-Assets Mode
+	MultiAssetsMode bool `json:"j"`
+}
+
+type FuturesWS_StrategyUpdate struct {
+
+	// Event Type
+	Event string `json:"e"`
+
+	// Event Time
+	EventTime int64 `json:"E"`
+
+	// Transaction Time
+	TransactionTime int64 `json:"T"`
+
+	StrategyUpdate *FuturesWS_StrategyUpdate_Strategy `json:"su"`
+}
+
+type FuturesWS_StrategyUpdate_Strategy struct {
+
+	// Strategy Id
+	StrategyId int64 `json:"si"`
+
+	// Strategy Type
+	StrategyType string `json:"st"`
+
+	// Strategy Status
+	StrategyStatus string `json:"ss"`
+
+	// Symbol
+	Symbol string `json:"s"`
+
+	// Update Time
+	UpdateTime int64 `json:"ut"`
+
+	// opCode
+	OpCode int64 `json:"c"`
+}
+
+// Every handler is optional, events without a handler are ignored
+type FuturesWS_UserData_Handlers struct {
+	OnAccountUpdate       func(accountUpdate *FuturesWS_AccountUpdate)
+	OnOrderTradeUpdate    func(orderTradeUpdate *FuturesWS_OrderTradeUpdate)
+	OnTradeLite           func(tradeLite *FuturesWS_TradeLite)
+	OnMarginCall          func(marginCall *FuturesWS_MarginCall)
+	OnAccountConfigUpdate func(accountConfigUpdate *FuturesWS_AccountConfigUpdate)
+	OnStrategyUpdate      func(strategyUpdate *FuturesWS_StrategyUpdate)
+
+	// Called after the listenKey has expired
+	// The socket renews its listenKey and reconnects on its own
+	OnListenKeyExpired func(listenKeyExpired *FuturesWS_ListenKeyExpired)
+
+	// Called for any event type this library doesn't decode yet
+	OnUnknownEvent func(eventType string, msg []byte)
+}
+
+// Implemented by both the USDⓈ-M and COIN-M clients
+type futures_ListenKey_Client interface {
+	CreateListenKey() (*Futures_ListenKey, *Response, *Error)
+	KeepAliveListenKey() (*Futures_ListenKey, *Response, *Error)
+	CloseListenKey() (*Response, *Error)
+}
+
+type FuturesWS_UserData_Socket struct {
+	Handler  *Futures_Websocket
+	Handlers *FuturesWS_UserData_Handlers
+
+	listenKeyClient futures_ListenKey_Client
+
+	mu            sync.Mutex
+	listenKey     string
+	stopKeepAlive chan struct{}
+}
+
+func (socket *FuturesWS_UserData_Socket) ListenKey() string {
+	socket.mu.Lock()
+	defer socket.mu.Unlock()
+
+	return socket.listenKey
+}
+
+// Stops the keepalive, closes the listenKey and closes the socket indefinitely
+func (socket *FuturesWS_UserData_Socket) Close() error {
+	socket.mu.Lock()
+	if socket.stopKeepAlive != nil {
+		close(socket.stopKeepAlive)
+		socket.stopKeepAlive = nil
+	}
+	socket.mu.Unlock()
+
+	_, err := socket.listenKeyClient.CloseListenKey()
+	if err != nil {
+		LOG_WS_ERRORS("[USERDATA] There was an error closing the listenKey:", err.Error())
+	}
+
+	return socket.Handler.Close()
+}
+
+// Creates (or retrieves) the account's listenKey and points the socket's stream to it
+func (socket *FuturesWS_UserData_Socket) renewListenKey() *Error {
+	listenKey, _, err := socket.listenKeyClient.CreateListenKey()
+	if err != nil {
+		return err
+	}
+
+	socket.mu.Lock()
+	socket.listenKey = listenKey.ListenKey
+	socket.mu.Unlock()
+
+	if socket.Handler != nil {
+		socket.Handler.Websocket.setStreams([]string{listenKey.ListenKey})
+	}
+
+	return nil
+}
+
+func (socket *FuturesWS_UserData_Socket) keepAlive(stop chan struct{}) {
+	ticker := time.NewTicker(time.Duration(FUTURES_Constants.Websocket.LISTENKEY_KEEPALIVE_INTERVAL_SEC) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		// Closed without Close(), i.e: with Handler.Close() or after giving up reconnecting
+		case <-socket.Handler.Websocket.closing:
+			return
+		case <-ticker.C:
+		}
+
+		listenKey, _, err := socket.listenKeyClient.KeepAliveListenKey()
+		if err != nil {
+			LOG_WS_ERRORS("[USERDATA] There was an error keeping the listenKey alive:", err.Error())
+
+			// -1125: This listenKey does not exist.
+			if err.IsLocalError || err.Code != -1125 {
+				continue
+			}
+		} else if listenKey.ListenKey == "" || listenKey.ListenKey == socket.ListenKey() {
+			continue
+		}
+
+		LOG_WS_VERBOSE("[USERDATA] listenKey is no longer valid, reconnecting with a new one...")
+		socket.Handler.Reconnect()
+	}
+}
+
+func (socket *FuturesWS_UserData_Socket) handleMessage(stream string, msg []byte) {
+	var event FuturesWS_UserData_Event
+	err := json.Unmarshal(msg, &event)
+	if err != nil {
+		socket.Handler.Websocket.decodeError(stream, msg, err)
+		return
+	}
+
+	handlers := socket.Handlers
+	eventTypes := FUTURES_Constants.UserDataEventTypes
+
+	switch event.Event {
+	case eventTypes.LISTEN_KEY_EXPIRED:
+		var listenKeyExpired FuturesWS_ListenKeyExpired
+		err := json.Unmarshal(msg, &listenKeyExpired)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		if handlers.OnListenKeyExpired != nil {
+			handlers.OnListenKeyExpired(&listenKeyExpired)
+		}
+
+		// The read loop must not be blocked by the reconnection
+		go socket.Handler.Reconnect()
+
+	case eventTypes.ACCOUNT_UPDATE:
+		if handlers.OnAccountUpdate == nil {
+			return
+		}
+		var accountUpdate FuturesWS_AccountUpdate
+		err := json.Unmarshal(msg, &accountUpdate)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		handlers.OnAccountUpdate(&accountUpdate)
+
+	case eventTypes.ORDER_TRADE_UPDATE:
+		if handlers.OnOrderTradeUpdate == nil {
+			return
+		}
+		var orderTradeUpdate FuturesWS_OrderTradeUpdate
+		err := json.Unmarshal(msg, &orderTradeUpdate)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		handlers.OnOrderTradeUpdate(&orderTradeUpdate)
+
+	case eventTypes.TRADE_LITE:
+		if handlers.OnTradeLite == nil {
+			return
+		}
+		var tradeLite FuturesWS_TradeLite
+		err := json.Unmarshal(msg, &tradeLite)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		handlers.OnTradeLite(&tradeLite)
+
+	case eventTypes.MARGIN_CALL:
+		if handlers.OnMarginCall == nil {
+			return
+		}
+		var marginCall FuturesWS_MarginCall
+		err := json.Unmarshal(msg, &marginCall)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		handlers.OnMarginCall(&marginCall)
+
+	case eventTypes.ACCOUNT_CONFIG_UPDATE:
+		if handlers.OnAccountConfigUpdate == nil {
+			return
+		}
+		var accountConfigUpdate FuturesWS_AccountConfigUpdate
+		err := json.Unmarshal(msg, &accountConfigUpdate)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		handlers.OnAccountConfigUpdate(&accountConfigUpdate)
+
+	case eventTypes.STRATEGY_UPDATE:
+		if handlers.OnStrategyUpdate == nil {
+			return
+		}
+		var strategyUpdate FuturesWS_StrategyUpdate
+		err := json.Unmarshal(msg, &strategyUpdate)
+		if err != nil {
+			socket.Handler.Websocket.decodeError(stream, msg, err)
+			return
+		}
+		handlers.OnStrategyUpdate(&strategyUpdate)
+
+	default:
+		if handlers.OnUnknownEvent != nil {
+			handlers.OnUnknownEvent(event.Event, msg)
+		}
+	}
+}
+
+// # User Data Streams
+//
+// Opens the account's user data stream.
+//
+// The listenKey is created, kept alive every 30 minutes, and renewed on expiry (the socket reconnects with the new listenKey on its own).
+//
+// Calling 'Close()' on the returned socket also closes the listenKey.
+func (futures_ws *Futures_Websockets) UserData(handlers *FuturesWS_UserData_Handlers) (*FuturesWS_UserData_Socket, *Error) {
+	return createUserDataSocket(&futures_ws.binance.Futures, FUTURES_Constants.Websocket.URLs[0], handlers)
+}
+
+func createUserDataSocket(listenKeyClient futures_ListenKey_Client, baseURL string, handlers *FuturesWS_UserData_Handlers) (*FuturesWS_UserData_Socket, *Error) {
+	if handlers == nil {
+		handlers = &FuturesWS_UserData_Handlers{}
+	}
+
+	newSocket := &FuturesWS_UserData_Socket{
+		Handlers:        handlers,
+		listenKeyClient: listenKeyClient,
+	}
+
+	err := newSocket.renewListenKey()
+	if err != nil {
+		return nil, err
+	}
+
+	socket, err := createFuturesSocket(baseURL, []string{newSocket.ListenKey()}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.Websocket.setStreamDecoder(func(stream string, msg []byte) {
+		newSocket.handleMessage(stream, msg)
+	})
+	socket.Websocket.setBeforeReconnect(func() {
+		err := newSocket.renewListenKey()
+		if err != nil {
+			LOG_WS_ERRORS("[USERDATA] There was an error renewing the listenKey:", err.Error())
+		}
+	})
+
+	newSocket.Handler = socket
+
+	stop := make(chan struct{})
+	newSocket.stopKeepAlive = stop
+	socket.Websocket.goroutine(func() { newSocket.keepAlive(stop) })
+
+	return newSocket, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (*Futures_Websockets) CreateSocket(streams []string, isCombined bool) (*Futures_Websocket, *Error) {
+	return createFuturesSocket(FUTURES_Constants.Websocket.URLs[0], streams, isCombined)
+}
+
+// Shared between the USDⓈ-M and COIN-M websockets, they speak the same protocol
+func createFuturesSocket(baseURL string, streams []string, isCombined bool) (*Futures_Websocket, *Error) {
+	socket, err := CreateSocket(baseURL, streams, isCombined)
+	if err != nil {
+		return nil, err
+	}
+
+	socket.setPrivateMessageValidator(func(msg []byte) (isPrivate bool, Id string) {
+
+		if len(msg) > 0 && msg[0] == '[' {
+			return false, ""
+		}
+
+		var privateMessage FuturesWS_PrivateMessage
+		err := json.Unmarshal(msg, &privateMessage)
+		if err != nil {
+			LOG_WS_ERRORS("[PRIVATEMESSAGEVALIDATOR ERR] WS Message is the following:", string(msg))
+			LocalError(PARSING_ERR, err.Error())
+			return false, ""
 		}
+
+		if privateMessage.Id == "" {
+			return false, ""
+		}
+
+		return true, privateMessage.Id
+	})
+
+	ws := &Futures_Websocket{
+		Websocket: socket,
+		BaseURL:   baseURL,
+	}
+
+	return ws, nil
+}
+
+type FuturesWS_ListSubscriptions_Response struct {
+	Id     string   `json:"id"`
+	Result []string `json:"result"`
+}
+
+func (futures_ws *Futures_Websocket) ListSubscriptions(timeout_sec ...int) (resp *FuturesWS_ListSubscriptions_Response, hasTimedOut bool, err *Error) {
+	response, timedOut, err := futures_ws.Websocket.streamRequest("LIST_SUBSCRIPTIONS", nil, timeout_sec...)
+	if err != nil {
+		return nil, timedOut, err
+	}
+
+	return &FuturesWS_ListSubscriptions_Response{Id: response.Id, Result: response.Result}, false, nil
+}
+
+type FuturesWS_Subscribe_Response struct {
+	Id string `json:"id"`
+}
+
+// Each call is a single message, pass every stream at once instead of calling it in a loop
+//
+// Messages beyond Constants.Websocket.MAX_OUTGOING_MESSAGES_PER_SECOND wait for their turn, the timeout starts once the message is sent
+//
+// The streams are only added to the socket once Binance accepts them, a rejection is returned as an error
+func (futures_ws *Futures_Websocket) Subscribe(stream ...string) (resp *FuturesWS_Subscribe_Response, hasTimedOut bool, err *Error) {
+	Id, timedOut, err := futures_ws.Websocket.updateSubscriptions("SUBSCRIBE", stream)
+	if err != nil {
+		return nil, timedOut, err
+	}
+
+	LOG_WS_VERBOSE("Successfully Subscribed to", stream)
+
+	return &FuturesWS_Subscribe_Response{Id: Id}, false, nil
+}
+
+type FuturesWS_Unsubscribe_Response struct {
+	Id string `json:"id"`
+}
+
+// Each call is a single message, pass every stream at once instead of calling it in a loop
+//
+// Messages beyond Constants.Websocket.MAX_OUTGOING_MESSAGES_PER_SECOND wait for their turn, the timeout starts once the message is sent
+//
+// The streams are only removed from the socket once Binance accepts it, a rejection is returned as an error
+func (futures_ws *Futures_Websocket) Unsubscribe(stream ...string) (resp *FuturesWS_Unsubscribe_Response, hasTimedOut bool, err *Error) {
+	Id, timedOut, err := futures_ws.Websocket.updateSubscriptions("UNSUBSCRIBE", stream)
+	if err != nil {
+		return nil, timedOut, err
 	}
-	futures_ws.Websocket.Streams = updatedStreams
 
 	LOG_WS_VERBOSE("Successfully Unsubscribed from", stream)
 
-	return &response, false, nil
+	return &FuturesWS_Unsubscribe_Response{Id: Id}, false, nil
 }
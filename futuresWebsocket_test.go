@@ -0,0 +1,109 @@
+package Binance
+
+import (
+	"testing"
+	"time"
+
+	ws "github.com/gorilla/websocket"
+)
+
+type testListenKeyClient struct{}
+
+func (testListenKeyClient) CreateListenKey() (*Futures_ListenKey, *Response, *Error) {
+	return &Futures_ListenKey{ListenKey: "listenKey"}, nil, nil
+}
+
+func (testListenKeyClient) KeepAliveListenKey() (*Futures_ListenKey, *Response, *Error) {
+	return &Futures_ListenKey{ListenKey: "listenKey"}, nil, nil
+}
+
+func (testListenKeyClient) CloseListenKey() (*Response, *Error) {
+	return nil, nil
+}
+
+func TestFuturesUserDataKeepAliveStopsWithTheSocket(t *testing.T) {
+	url := newTestWebsocketServer(t, func(conn *ws.Conn) {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+
+	socket, err := createUserDataSocket(testListenKeyClient{}, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Closed through the websocket rather than the user data socket
+	socket.Handler.Close()
+
+	stopped := make(chan struct{})
+	go func() {
+		socket.Handler.Websocket.routines.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the listenKey keepalive kept running after the socket was closed")
+	}
+}
+
+func TestFuturesManagedOrderbookAddEventIsExact(t *testing.T) {
+	orderbook := &Futures_ManagedOrderbook{
+		Symbol: "BTCUSDT",
+		Bids: [][2]Decimal{
+			{MustParseDecimal("100.2"), MustParseDecimal("1")},
+			{MustParseDecimal("100.1"), MustParseDecimal("2")},
+		},
+		Asks: [][2]Decimal{
+			{MustParseDecimal("100.3"), MustParseDecimal("1")},
+			{MustParseDecimal("100.5"), MustParseDecimal("2")},
+		},
+	}
+
+	orderbook.addEvent(&FuturesWS_DiffBookDepth{
+		Bids: [][2]string{
+			{"100.20", "0.000"},
+			{"100.15", "0.3"},
+			{"100.10", "2.5"},
+			{"bad", "1"},
+		},
+		Asks: [][2]string{
+			{"100.4", "0.1"},
+			{"100.50000", "0"},
+		},
+	})
+
+	checkLevels := func(side string, levels [][2]Decimal, expected [][2]string) {
+		t.Helper()
+		if len(levels) != len(expected) {
+			t.Fatalf("%s: expected %d levels, got %v", side, len(expected), levels)
+		}
+		for i, level := range levels {
+			if level[0].String() != expected[i][0] || level[1].String() != expected[i][1] {
+				t.Fatalf("%s[%d]: expected %v, got [%s %s]", side, i, expected[i], level[0], level[1])
+			}
+		}
+	}
+
+	checkLevels("bids", orderbook.Bids, [][2]string{{"100.15", "0.3"}, {"100.1", "2.5"}})
+	checkLevels("asks", orderbook.Asks, [][2]string{{"100.3", "1"}, {"100.4", "0.1"}})
+}
+
+func TestFuturesOrderDecodesDecimals(t *testing.T) {
+	var order Futures_Order
+	err := json.Unmarshal([]byte(`{"price":"0.10000000","origQty":"3","executedQty":"","avgPrice":"0.3"}`), &order)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !order.Price.Mul(order.OrigQty).Equal(MustParseDecimal("0.3")) || !order.AvgPrice.Equal(MustParseDecimal("0.3")) {
+		t.Fatalf("expected price * origQty == avgPrice == 0.3, got %s * %s, %s", order.Price, order.OrigQty, order.AvgPrice)
+	}
+	if !order.ExecutedQty.IsZero() {
+		t.Fatalf("expected an empty executedQty to decode as zero, got %s", order.ExecutedQty)
+	}
+}